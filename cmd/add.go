@@ -13,26 +13,27 @@ var addCmd = &cobra.Command{
 	Use:   "add",
 	Short: "Add a new task to your task list",
 	Long: `Add a new task with a short description. 
-The task will be saved to the JSON storage and assigned a unique ID.
+The task will be saved to the JSON storage and assigned a unique ID and UUID.
+IDs are never reused, even after the task that owned them was deleted.
 
 Example usage:
   task-cli add "Buy groceries"
 Output:
-  Task added successfully (ID: 1)`,
-	Run: func(cmd *cobra.Command, args []string) {
+  Task added successfully (ID: 1, UUID: 3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f)`,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) == 0 {
-			cmd.Println("Error: Task description is required.")
-			return
+			return newUsageError("Task description is required.")
 		}
 
 		description := args[0]
 		task, err := tasks.AddTask(description)
 
 		if err != nil {
-			cmd.Printf("Error: %s\n", err.Error())
-		} else {
-			cmd.Printf("Task added successfully (ID: %d)\n", task.Id)
+			return err
 		}
+
+		cmd.Printf("Task added successfully (ID: %d, UUID: %s)\n", task.Id, task.Uuid)
+		return nil
 	},
 }
 
@@ -0,0 +1,46 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var agendaCmd = &cobra.Command{
+	Use:   "agenda",
+	Short: "Show the open tasks due in the next days",
+	Long: `Show the open tasks due in the next days grouped by day, starting today.
+Overdue tasks are listed first. Days follow the local calendar.
+
+Example usage:
+  task-cli agenda
+  task-cli agenda --days 14`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		days, _ := cmd.Flags().GetInt("days")
+
+		if days < 1 {
+			return newUsageError("The number of days must be at least 1.")
+		}
+
+		res, err := tasks.GetAgenda(days)
+
+		if err != nil {
+			return err
+		}
+
+		cmd.Print(res)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(agendaCmd)
+
+	agendaCmd.Flags().Int("days", tasks.DefaultAgendaDays, "Number of days to show, starting today")
+}
@@ -0,0 +1,84 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var archiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Move completed tasks into the archive",
+	Long: `Move done tasks that were completed before the given age into a separate archive file
+(archive.json next to tasks.json). Archived tasks no longer slow down everyday commands
+and can be viewed with "task-cli list --archived".
+
+Ages are written as a number followed by a unit: d (days), w (weeks), h (hours) or m (minutes).
+
+With --auto the same rule is applied automatically every time the task list is saved.
+Use "--auto off" to disable it again.
+
+Example usage:
+  # Archive tasks completed more than 30 days ago
+  task-cli archive --done-before 30d
+
+  # Archive tasks completed more than two weeks ago on every save
+  task-cli archive --auto 2w
+
+  # Disable automatic archiving
+  task-cli archive --auto off`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		doneBefore, _ := cmd.Flags().GetString("done-before")
+		auto, _ := cmd.Flags().GetString("auto")
+
+		if doneBefore == "" && auto == "" {
+			return newUsageError("Either --done-before or --auto is required.")
+		}
+
+		if auto != "" {
+			if auto == "off" {
+				auto = ""
+			}
+
+			if err := tasks.SetAutoArchivePolicy(auto); err != nil {
+				return err
+			}
+
+			if auto == "" {
+				cmd.Println("Automatic archiving disabled.")
+			} else {
+				cmd.Printf("Done tasks older than %s will be archived automatically.\n", auto)
+			}
+		}
+
+		if doneBefore == "" {
+			return nil
+		}
+
+		age, err := tasks.ParseAgeString(doneBefore)
+		if err != nil {
+			return &usageError{message: err.Error()}
+		}
+
+		count, err := tasks.ArchiveTasks(age)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Archived %d task(s).\n", count)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(archiveCmd)
+
+	archiveCmd.Flags().String("done-before", "", "Archive done tasks completed at least this long ago (e.g. 30d)")
+	archiveCmd.Flags().String("auto", "", "Archive done tasks older than this age on every save, or \"off\"")
+}
@@ -0,0 +1,65 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"strings"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var assignCmd = &cobra.Command{
+	Use:   "assign <id> <user>",
+	Short: "Assign a task to a user",
+	Long: `Make a user responsible for a task. New tasks are assigned to the user who adds them,
+the name set as user.name in the configuration or the login name of the OS user.
+
+The task can be referenced by its numeric ID or by a unique prefix of its UUID.
+
+Example usage:
+  task-cli assign 1 alice
+  task-cli assign 3f2a9c bob
+  task-cli assign 1 --clear`,
+	ValidArgsFunction: completeAssign,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		remove, _ := cmd.Flags().GetBool("clear")
+
+		if remove && len(args) != 1 {
+			return newUsageError("Only Task ID is required with --clear.")
+		}
+
+		if !remove && (len(args) != 2 || strings.TrimSpace(args[1]) == "") {
+			return newUsageError("Task ID and user are required.")
+		}
+
+		id, err := tasks.ResolveTaskId(args[0])
+		if err != nil {
+			return err
+		}
+
+		assignee := ""
+		if !remove {
+			assignee = strings.TrimSpace(args[1])
+		}
+
+		if err := tasks.AssignTask(id, assignee); err != nil {
+			return err
+		}
+
+		if remove {
+			cmd.Println("Task unassigned.")
+		} else {
+			cmd.Printf("Task assigned to %s.\n", assignee)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(assignCmd)
+
+	assignCmd.Flags().Bool("clear", false, "Remove the assignee of the task")
+}
@@ -0,0 +1,125 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/config"
+	taskdomain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/terminal"
+	"github.com/spf13/cobra"
+)
+
+const defaultTerminalWidth = 80
+
+var boardCmd = &cobra.Command{
+	Use:   "board",
+	Short: "Show tasks as a kanban board",
+	Long: `Render the tasks as a kanban board with one column of task cards per status.
+The board is sized to the terminal width unless --width is given.
+
+Tasks can be narrowed down to a #tag or a +project written in their description.
+A warning is shown when a column holds more tasks than allowed by board.wip_limits in the
+configuration or by --wip-limit, which overrides the configured limit of the columns it names.
+
+Example usage:
+  task-cli board
+  task-cli board --tag urgent
+  task-cli board --project website --columns todo,in-progress
+  task-cli board --wip-limit in-progress=3`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		var options tasks.BoardOptions
+		options.Tag, _ = cmd.Flags().GetString("tag")
+		options.Project, _ = cmd.Flags().GetString("project")
+
+		columns, _ := cmd.Flags().GetStringSlice("columns")
+		if len(columns) == 0 {
+			columns = strings.Split(appConfig.Value(config.BoardColumns), ",")
+		}
+
+		for _, column := range columns {
+			status, err := tasks.ParseStatusString(strings.TrimSpace(column))
+			if err != nil {
+				return &usageError{message: err.Error()}
+			}
+
+			options.Statuses = append(options.Statuses, status)
+		}
+
+		options.WipLimits = make(map[taskdomain.Status]int)
+		for column, limit := range appConfig.WipLimits() {
+			status, err := tasks.ParseStatusString(column)
+			if err != nil {
+				return err
+			}
+
+			options.WipLimits[status] = limit
+		}
+
+		limits, _ := cmd.Flags().GetStringToInt("wip-limit")
+		for column, limit := range limits {
+			status, err := tasks.ParseStatusString(column)
+			if err != nil {
+				return &usageError{message: err.Error()}
+			}
+
+			if limit < 0 {
+				return newUsageError("Invalid --wip-limit %s=%d, expected a non-negative number of tasks.", column, limit)
+			}
+
+			options.WipLimits[status] = limit
+		}
+
+		width, _ := cmd.Flags().GetInt("width")
+		if width <= 0 {
+			width = detectTerminalWidth()
+		}
+
+		res, err := tasks.GetBoard(options, width)
+
+		if err != nil {
+			return err
+		}
+
+		cmd.Print(res)
+		return nil
+	},
+}
+
+// detectTerminalWidth asks the terminal for its width, falling back to $COLUMNS and a fixed default.
+func detectTerminalWidth() int {
+	if terminal.IsTerminal(os.Stdout.Fd()) {
+		if width, _, err := terminal.Size(os.Stdout.Fd()); err == nil && width > 0 {
+			return width
+		}
+	}
+
+	if width, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && width > 0 {
+		return width
+	}
+
+	return defaultTerminalWidth
+}
+
+func init() {
+	rootCmd.AddCommand(boardCmd)
+
+	boardCmd.Flags().String("tag", "", "Only show tasks with this #tag")
+	boardCmd.Flags().String("project", "", "Only show tasks of this +project")
+	boardCmd.Flags().StringSlice("columns", nil, "Statuses to show as columns, in order (default board.columns from the configuration)")
+	boardCmd.Flags().StringToInt("wip-limit", nil, "Maximum number of tasks per column, e.g. in-progress=3 (default board.wip_limits from the configuration)")
+	boardCmd.Flags().Int("width", 0, "Board width in characters (default: terminal width)")
+
+	_ = boardCmd.RegisterFlagCompletionFunc("tag", completeMarkers(taskdomain.Task.Tags))
+	_ = boardCmd.RegisterFlagCompletionFunc("project", completeMarkers(taskdomain.Task.Projects))
+	_ = boardCmd.RegisterFlagCompletionFunc("columns", completeStatusList)
+}
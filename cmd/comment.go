@@ -0,0 +1,79 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"strings"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var commentCmd = &cobra.Command{
+	Use:   "comment <id> <text>",
+	Short: "Comment on a task",
+	Long: `Add a comment to a task, or edit or delete one of your own comments.
+Comments are signed with the name set as user.name in the configuration or the
+login name of the OS user. "task-cli show <id>" lists the comments of a task.
+
+The task can be referenced by its numeric ID or by a unique prefix of its UUID.
+
+Example usage:
+  task-cli comment 1 "Draft is in the wiki"
+  task-cli comment 1 --edit 2 "Draft is in the shared folder"
+  task-cli comment 1 --delete 2`,
+	ValidArgsFunction: completeTaskIds(),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		edit, _ := cmd.Flags().GetInt("edit")
+		remove, _ := cmd.Flags().GetInt("delete")
+
+		if edit != 0 && remove != 0 {
+			return newUsageError("Use either --edit or --delete.")
+		}
+
+		if remove != 0 && len(args) != 1 {
+			return newUsageError("Only Task ID is required with --delete.")
+		}
+
+		if remove == 0 && (len(args) != 2 || strings.TrimSpace(args[1]) == "") {
+			return newUsageError("Task ID and the text of the comment are required.")
+		}
+
+		id, err := tasks.ResolveTaskId(args[0])
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case remove != 0:
+			if err := tasks.DeleteComment(id, remove); err != nil {
+				return err
+			}
+
+			cmd.Println("Comment deleted successfully.")
+		case edit != 0:
+			if err := tasks.EditComment(id, edit, args[1]); err != nil {
+				return err
+			}
+
+			cmd.Println("Comment updated successfully.")
+		default:
+			comment, err := tasks.AddComment(id, args[1])
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Comment added successfully (#%d).\n", comment.Id)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(commentCmd)
+
+	commentCmd.Flags().Int("edit", 0, "Number of your comment to replace with the text")
+	commentCmd.Flags().Int("delete", 0, "Number of your comment to delete")
+}
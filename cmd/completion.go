@@ -0,0 +1,187 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/config"
+	taskdomain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/spf13/cobra"
+)
+
+var completionCmd = &cobra.Command{
+	Use:   "completion <bash|zsh|fish>",
+	Short: "Generate a shell completion script",
+	Long: `Print a completion script for bash, zsh or fish to standard output.
+
+Besides commands and flags the completions suggest task IDs with their descriptions,
+status names for "list" and "board --columns", and the #tags and +projects in use.
+
+Example usage:
+  # bash, for the current shell or permanently
+  source <(task-cli completion bash)
+  task-cli completion bash > /etc/bash_completion.d/task-cli
+
+  # zsh
+  task-cli completion zsh > "${fpath[1]}/_task-cli"
+
+  # fish
+  task-cli completion fish > ~/.config/fish/completions/task-cli.fish`,
+	ValidArgs: []string{"bash", "zsh", "fish"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return newUsageError("Only the shell is required: bash, zsh or fish.")
+		}
+
+		out := cmd.OutOrStdout()
+
+		switch args[0] {
+		case "bash":
+			return rootCmd.GenBashCompletionV2(out, true)
+		case "zsh":
+			return rootCmd.GenZshCompletion(out)
+		case "fish":
+			return rootCmd.GenFishCompletion(out, true)
+		default:
+			return newUsageError("Unsupported shell %q, use bash, zsh or fish.", args[0])
+		}
+	},
+}
+
+// completeTaskIds suggests the IDs of the tasks, described by their description and status, as the first argument.
+// Tasks already in one of the skipped statuses are left out, e.g. done tasks for mark-done.
+func completeTaskIds(skipped ...taskdomain.Status) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		list, err := tasks.ListTasks()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		var completions []cobra.Completion
+		for _, task := range list {
+			id := strconv.Itoa(task.Id)
+
+			if slices.Contains(skipped, task.CurrentStatus) || !strings.HasPrefix(id, toComplete) {
+				continue
+			}
+
+			description := fmt.Sprintf("%s (%s)", task.Description, task.CurrentStatus.String())
+			completions = append(completions, cobra.CompletionWithDesc(id, description))
+		}
+
+		return completions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+	}
+}
+
+// completeListFilter suggests the status filters of the list command.
+func completeListFilter(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return append([]cobra.Completion{allFilter}, statusNames()...), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeStatusList suggests status names for a comma separated list, keeping the statuses already typed.
+func completeStatusList(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	typed := ""
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		typed = toComplete[:i+1]
+	}
+
+	var completions []cobra.Completion
+	for _, status := range statusNames() {
+		if !slices.Contains(strings.Split(typed, ","), status) {
+			completions = append(completions, typed+status)
+		}
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
+// completeMarkers suggests the tags or projects, depending on markers, written in the task descriptions.
+func completeMarkers(markers func(taskdomain.Task) []string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		list, err := tasks.ListTasks()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		var completions []cobra.Completion
+		for _, task := range list {
+			for _, marker := range markers(task) {
+				if !slices.Contains(completions, marker) {
+					completions = append(completions, marker)
+				}
+			}
+		}
+
+		slices.Sort(completions)
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeConfigKeys suggests the setting keys as the first argument of the config commands.
+func completeConfigKeys(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, key := range config.Keys() {
+		completions = append(completions, cobra.CompletionWithDesc(key, config.Description(key)))
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeTemplateNames suggests the names of the saved task templates.
+func completeTemplateNames(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names, err := tasks.ListTemplates()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeUsers suggests the assignees and creators of the tasks.
+func completeUsers(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	users, err := tasks.ListUsers()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return users, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeAssign suggests a task ID as the first argument of assign and a user as the second.
+func completeAssign(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) == 1 {
+		return completeUsers(cmd, args, toComplete)
+	}
+
+	return completeTaskIds()(cmd, args, toComplete)
+}
+
+func statusNames() []string {
+	return []string{taskdomain.TodoStr, taskdomain.InProgressStr, taskdomain.DoneStr}
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"bytes"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+// complete asks cobra's hidden __complete command for the suggestions of the command line args.
+func complete(t *testing.T, args ...string) []string {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	require.Equal(t, exitOK, run(append([]string{cobra.ShellCompRequestCmd}, args...), &stdout, &stderr), stderr.String())
+
+	// The last line holds the completion directive.
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	completions := make([]string, 0, len(lines))
+
+	for _, line := range lines[:len(lines)-1] {
+		if line != "" {
+			completions = append(completions, line)
+		}
+	}
+
+	return completions
+}
+
+func TestCompletion(t *testing.T) {
+	setupTaskStore(t)
+
+	for _, args := range [][]string{
+		{"add", "Buy milk #home"},
+		{"add", "Write report +work #urgent"},
+		{"add", "Call mom #home"},
+		{"mark-done", "3"},
+		{"assign", "2", "bob"},
+	} {
+		var out bytes.Buffer
+		require.Equal(t, exitOK, run(args, &out, &out), out.String())
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "Task ids with descriptions",
+			args:     []string{"delete", ""},
+			expected: []string{"1\tBuy milk #home (todo)", "2\tWrite report +work #urgent (todo)", "3\tCall mom #home (done)"},
+		},
+		{
+			name:     "Done tasks are not offered to mark-done",
+			args:     []string{"mark-done", ""},
+			expected: []string{"1\tBuy milk #home (todo)", "2\tWrite report +work #urgent (todo)"},
+		},
+		{
+			name:     "Id prefix",
+			args:     []string{"update", "3"},
+			expected: []string{"3\tCall mom #home (done)"},
+		},
+		{
+			name:     "Only the first argument is a task id",
+			args:     []string{"update", "1", ""},
+			expected: []string{},
+		},
+		{
+			name:     "List filters",
+			args:     []string{"list", ""},
+			expected: []string{"all", "todo", "in-progress", "done"},
+		},
+		{
+			name:     "Tags",
+			args:     []string{"board", "--tag", ""},
+			expected: []string{"home", "urgent"},
+		},
+		{
+			name:     "Projects",
+			args:     []string{"board", "--project", ""},
+			expected: []string{"work"},
+		},
+		{
+			name:     "Status list keeps the typed columns",
+			args:     []string{"board", "--columns", "todo,"},
+			expected: []string{"todo,in-progress", "todo,done"},
+		},
+		{
+			name:     "Users",
+			args:     []string{"list", "--assignee", ""},
+			expected: []string{"alice", "bob"},
+		},
+		{
+			name:     "Assignee is the second argument of assign",
+			args:     []string{"assign", "1", ""},
+			expected: []string{"alice", "bob"},
+		},
+		{
+			name:     "Shells",
+			args:     []string{"completion", ""},
+			expected: []string{"bash", "zsh", "fish"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, complete(t, tt.args...))
+		})
+	}
+}
+
+func TestCompletionScripts(t *testing.T) {
+	setupTaskStore(t)
+
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		var stdout, stderr bytes.Buffer
+
+		assert.Equal(t, exitOK, run([]string{"completion", shell}, &stdout, &stderr), shell)
+		assert.Contains(t, stdout.String(), cobra.ShellCompRequestCmd, shell)
+		assert.Empty(t, stderr.String(), shell)
+	}
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, exitUsage, run([]string{"completion", "tcsh"}, &stdout, &stderr))
+	assert.Equal(t, "Error: Unsupported shell \"tcsh\", use bash, zsh or fish.\n", stderr.String())
+}
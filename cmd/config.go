@@ -0,0 +1,133 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Show and change the configuration",
+	Long: `Show and change the settings stored in the configuration file.
+
+The file lives in $XDG_CONFIG_HOME/TaskTracker-CLI/config.json (on Windows in %AppData%)
+unless --config or the TASK_CLI_CONFIG environment variable points somewhere else.
+Every setting can also be overridden with an environment variable, see "task-cli config list".
+
+Example usage:
+  task-cli config list
+  task-cli config get display.date_format
+  task-cli config set list.default_filter todo
+  task-cli config set list.default_filter ""   # back to the default
+  task-cli config path`,
+}
+
+var configGetCmd = &cobra.Command{
+	Use:               "get <key>",
+	Short:             "Print the value of a setting",
+	ValidArgsFunction: completeConfigKeys,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return newUsageError("Setting key is required.")
+		}
+
+		value, err := appConfig.Get(args[0])
+		if err != nil {
+			return &usageError{message: err.Error()}
+		}
+
+		cmd.Println(value)
+		return nil
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:               "set <key> <value>",
+	Short:             "Change a setting in the configuration file",
+	ValidArgsFunction: completeConfigKeys,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 2 {
+			return newUsageError("Setting key and value are required.")
+		}
+
+		if err := appConfig.Set(args[0], args[1]); err != nil {
+			return &usageError{message: err.Error()}
+		}
+
+		if err := appConfig.Save(); err != nil {
+			return err
+		}
+
+		if args[1] == "" {
+			cmd.Printf("%s reset to the default.\n", args[0])
+		} else {
+			cmd.Printf("%s set to %q.\n", args[0], args[1])
+		}
+
+		if _, source, _ := appConfig.Lookup(args[0]); source == config.FromEnvironment {
+			cmd.Printf("Note: %s overrides this setting.\n", config.EnvName(args[0]))
+		}
+
+		return nil
+	},
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all settings with their values",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		for _, key := range config.Keys() {
+			value, source, _ := appConfig.Lookup(key)
+
+			cmd.Printf("%s = %q (%s)\n", key, value, source.String())
+			cmd.Printf("    %s, env: %s\n", config.Description(key), config.EnvName(key))
+		}
+
+		return appConfig.Validate()
+	},
+}
+
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the location of the configuration file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		path, err := configFilePath(cmd)
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(path)
+		return nil
+	},
+}
+
+// isConfigCommand reports whether cmd is the config command or one of its subcommands.
+func isConfigCommand(cmd *cobra.Command) bool {
+	for ; cmd != nil; cmd = cmd.Parent() {
+		if cmd == configCmd {
+			return true
+		}
+	}
+
+	return false
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configPathCmd)
+}
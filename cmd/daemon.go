@@ -0,0 +1,93 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/config"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/files"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/notify"
+	"github.com/spf13/cobra"
+)
+
+var daemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Deliver task reminders",
+	Long: `Watch the task store and deliver reminders set with "task-cli remind" when they are due.
+
+Reminders are shown as desktop notifications with notify-send. When reminder.command is set
+in the configuration, that shell command is run instead with the title and the message as
+$1 and $2 and the task in TASK_ID, TASK_UUID, TASK_DESCRIPTION, TASK_STATUS, TASK_REMIND_AT
+and TASK_DUE_AT. Without either the reminders are printed.
+
+Fired reminders are recorded in reminders.json next to tasks.json, so a restarted daemon does
+not repeat them, and reminders that came due while it was not running are delivered on start.
+Reminders of done tasks are skipped. The daemon stops on Ctrl+C.
+
+Example usage:
+  task-cli daemon
+  task-cli daemon --interval 1m
+  task-cli daemon --once    # e.g. from cron`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		interval, _ := cmd.Flags().GetDuration("interval")
+		once, _ := cmd.Flags().GetBool("once")
+
+		if interval <= 0 {
+			return newUsageError("The interval must be positive.")
+		}
+
+		notifier := notify.New(appConfig.Value(config.ReminderCommand), cmd.OutOrStdout(), tasks.FormatDate)
+
+		fire := func() error {
+			reminded, err := tasks.FireReminders(notifier)
+
+			for _, task := range reminded {
+				cmd.Printf("Reminded of task %d: %s\n", task.Id, task.Description)
+			}
+
+			return err
+		}
+
+		if once {
+			return fire()
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		cmd.Printf("Watching reminders in %s, checking every %s.\n", files.SaveDir(), interval)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			// The daemon keeps running when a check fails, e.g. while another command rewrites the file.
+			if err := fire(); err != nil {
+				cmd.PrintErrf("Error: %s\n", err.Error())
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(daemonCmd)
+
+	daemonCmd.Flags().Duration("interval", 30*time.Second, "How often the task store is checked")
+	daemonCmd.Flags().Bool("once", false, "Deliver the due reminders and exit")
+}
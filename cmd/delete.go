@@ -4,7 +4,6 @@ Copyright © 2025 LEXVOLK
 package cmd
 
 import (
-	"fmt"
 	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
 	"github.com/spf13/cobra"
 )
@@ -15,27 +14,28 @@ var deleteCmd = &cobra.Command{
 	Long: `Remove a task permanently from the task list using its ID.
 This action cannot be undone.
 
+The task can be referenced by its numeric ID or by a unique prefix of its UUID.
+
 Example usage:
-  task-cli delete 1`,
-	Run: func(cmd *cobra.Command, args []string) {
+  task-cli delete 1
+  task-cli delete 3f2a9c`,
+	ValidArgsFunction: completeTaskIds(),
+	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
-			cmd.Println("Error: Only Task ID is required.")
-			return
+			return newUsageError("Only Task ID is required.")
 		}
 
-		var id int
-		_, err := fmt.Sscanf(args[0], "%d", &id)
+		id, err := tasks.ResolveTaskId(args[0])
 		if err != nil {
-			cmd.Println("Error: Invalid task ID format.")
-			return
+			return err
 		}
 
-		err = tasks.DeleteTask(id)
-		if err != nil {
-			cmd.Printf("Error: %s\n", err.Error())
-		} else {
-			cmd.Println("Task deleted successfully.")
+		if err := tasks.DeleteTask(id); err != nil {
+			return err
 		}
+
+		cmd.Println("Task deleted successfully.")
+		return nil
 	},
 }
 
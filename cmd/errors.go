@@ -0,0 +1,69 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+)
+
+// Exit codes of task-cli, documented in the README.
+const (
+	exitOK        = 0
+	exitFailure   = 1 // any error not listed below
+	exitUsage     = 2 // unknown command, invalid arguments, flags or other input
+	exitNotFound  = 3 // the referenced task, template or comment does not exist
+	exitInvalidID = 4 // the task reference is empty or matches several tasks
+	exitStorage   = 5 // the task files can not be read or written
+)
+
+// usageError reports a command line that can not be run as given.
+type usageError struct {
+	message string
+}
+
+func newUsageError(format string, args ...any) error {
+	return &usageError{message: fmt.Sprintf(format, args...)}
+}
+
+func (e *usageError) Error() string {
+	return e.message
+}
+
+// exitError ends task-cli with code after the command has already reported the problem itself.
+type exitError struct {
+	code int
+}
+
+func (e *exitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
+// exitCode maps the error of a command to the exit status of task-cli.
+func exitCode(err error) int {
+	var usageErr *usageError
+	var exitErr *exitError
+
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.As(err, &exitErr):
+		return exitErr.code
+	case errors.As(err, &usageErr):
+		return exitUsage
+	case errors.Is(err, tasks.ErrTaskNotFound), errors.Is(err, tasks.ErrTemplateNotFound),
+		errors.Is(err, tasks.ErrCommentNotFound):
+		return exitNotFound
+	case errors.Is(err, tasks.ErrInvalidID):
+		return exitInvalidID
+	case errors.Is(err, tasks.ErrInvalidInput):
+		return exitUsage
+	case errors.Is(err, tasks.ErrStorage):
+		return exitStorage
+	default:
+		return exitFailure
+	}
+}
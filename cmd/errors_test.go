@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/files"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "Success", err: nil, expected: exitOK},
+		{name: "Other error", err: assert.AnError, expected: exitFailure},
+		{name: "Usage error", err: newUsageError("Only Task ID is required."), expected: exitUsage},
+		{name: "Task not found", err: fmt.Errorf("delete: %w", tasks.ErrTaskNotFound), expected: exitNotFound},
+		{name: "Template not found", err: fmt.Errorf("apply: %w", tasks.ErrTemplateNotFound), expected: exitNotFound},
+		{name: "Comment not found", err: fmt.Errorf("edit: %w", tasks.ErrCommentNotFound), expected: exitNotFound},
+		{name: "Invalid id", err: tasks.ErrInvalidID, expected: exitInvalidID},
+		{name: "Invalid input", err: fmt.Errorf("stats: %w", tasks.ErrInvalidInput), expected: exitUsage},
+		{name: "Storage error", err: tasks.ErrStorage, expected: exitStorage},
+		{name: "Exit error", err: &exitError{code: 7}, expected: 7},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, exitCode(tt.err))
+		})
+	}
+}
+
+// setupTaskStore points the configuration and the task files of the commands at a temporary directory,
+// the commands run as the user alice.
+// Tests using it can not run in parallel, the commands keep global state.
+func setupTaskStore(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("TASK_CLI_CONFIG", filepath.Join(dir, "config.json"))
+	t.Setenv("TASK_CLI_STORAGE_PATH", dir)
+	t.Setenv("TASK_CLI_USER_NAME", "alice")
+	t.Cleanup(func() { files.SetSaveDir("") })
+
+	return dir
+}
+
+// TestRunExitCodes runs whole command lines against a temporary task store, the cases share it and run in order.
+func TestRunExitCodes(t *testing.T) {
+	dir := setupTaskStore(t)
+
+	tests := []struct {
+		name           string
+		args           []string
+		setup          func(t *testing.T)
+		expectedCode   int
+		expectedStdout string
+		expectedStderr string
+	}{
+		{
+			name:           "Add a task",
+			args:           []string{"add", "Buy milk"},
+			expectedCode:   exitOK,
+			expectedStdout: "Task added successfully (ID: 1",
+		},
+		{
+			name:           "Mark an existing task",
+			args:           []string{"mark-done", "1"},
+			expectedCode:   exitOK,
+			expectedStdout: "Task marked as done successfully.\n",
+		},
+		{
+			name:           "Missing task",
+			args:           []string{"delete", "42"},
+			expectedCode:   exitNotFound,
+			expectedStderr: "Error: task with id [42] not found\n",
+		},
+		{
+			name:           "Empty task id",
+			args:           []string{"update", " ", "Buy bread"},
+			expectedCode:   exitInvalidID,
+			expectedStderr: "Error: task id is empty\n",
+		},
+		{
+			name:           "Malformed task id",
+			args:           []string{"mark-done", "xyz"},
+			expectedCode:   exitInvalidID,
+			expectedStderr: "Error: invalid task reference [xyz]: use a task ID or a UUID prefix\n",
+		},
+		{
+			name:           "Missing arguments",
+			args:           []string{"mark-in-progress"},
+			expectedCode:   exitUsage,
+			expectedStderr: "Error: Only Task ID is required.\n",
+		},
+		{
+			name:           "Unknown command",
+			args:           []string{"frobnicate"},
+			expectedCode:   exitUsage,
+			expectedStderr: "Error: unknown command \"frobnicate\" for \"TaskTracker\"\n",
+		},
+		{
+			name:           "Watch archived tasks by status",
+			args:           []string{"list", "--archived", "--watch", "todo"},
+			expectedCode:   exitUsage,
+			expectedStderr: "Error: Archived tasks can not be filtered by progress.\n",
+		},
+		{
+			name:           "Focus on a missing task",
+			args:           []string{"focus", "42"},
+			expectedCode:   exitNotFound,
+			expectedStderr: "Error: task with id [42] not found\n",
+		},
+		{
+			name:           "Focus session without length",
+			args:           []string{"focus", "1", "--minutes", "0"},
+			expectedCode:   exitUsage,
+			expectedStderr: "Error: --minutes must be a positive number of minutes.\n",
+		},
+		{
+			name:           "Focus stats without sessions",
+			args:           []string{"focus", "stats"},
+			expectedCode:   exitOK,
+			expectedStdout: "Focus time this week by task\n  none\n",
+		},
+		{
+			name:           "Comment on a task",
+			args:           []string{"comment", "1", "Whole milk"},
+			expectedCode:   exitOK,
+			expectedStdout: "Comment added successfully (#1).\n",
+		},
+		{
+			name:           "Show the comments",
+			args:           []string{"show", "1"},
+			expectedCode:   exitOK,
+			expectedStdout: "    Whole milk\n",
+		},
+		{
+			name:           "Edit a missing comment",
+			args:           []string{"comment", "1", "--edit", "5", "Oat milk"},
+			expectedCode:   exitNotFound,
+			expectedStderr: "Error: comment 5 not found on task 1\n",
+		},
+		{
+			name:           "Unknown export format",
+			args:           []string{"export", "--format", "xml"},
+			expectedCode:   exitUsage,
+			expectedStderr: "Error: invalid export format: xml\n",
+		},
+		{
+			name:           "Malformed template variable",
+			args:           []string{"template", "apply", "release", "--var", "version"},
+			expectedCode:   exitUsage,
+			expectedStderr: "Error: invalid template variable \"version\", expected name=value\n",
+		},
+		{
+			name:           "Inverted stats range",
+			args:           []string{"stats", "--from", "2025-09-30", "--to", "2025-09-01"},
+			expectedCode:   exitUsage,
+			expectedStderr: "Error: invalid date range: 2025-09-30 is after 2025-09-01\n",
+		},
+		{
+			name:           "Negative WIP limit",
+			args:           []string{"board", "--wip-limit", "in-progress=-1"},
+			expectedCode:   exitUsage,
+			expectedStderr: "Error: Invalid --wip-limit in-progress=-1, expected a non-negative number of tasks.\n",
+		},
+		{
+			name:           "Unknown flag",
+			args:           []string{"search", "--fuzzy", "milk"},
+			expectedCode:   exitUsage,
+			expectedStderr: "Error: unknown flag: --fuzzy\n",
+		},
+		{
+			name: "Broken task file",
+			args: []string{"search", "milk"},
+			setup: func(t *testing.T) {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "tasks.json"), []byte("{"), 0644))
+			},
+			expectedCode:   exitStorage,
+			expectedStderr: "Error: task storage error: unexpected EOF\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(t)
+			}
+
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+
+			assert.Equal(t, tt.expectedCode, code)
+			assert.Contains(t, stdout.String(), tt.expectedStdout)
+
+			if tt.expectedStderr == "" {
+				assert.Empty(t, stderr.String())
+			} else {
+				assert.Equal(t, tt.expectedStderr, stderr.String())
+			}
+		})
+	}
+}
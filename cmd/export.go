@@ -0,0 +1,69 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export tasks to todo.txt, Markdown, iCalendar or CSV",
+	Long: `Write all tasks in a format other tools understand.
+The output goes to standard output unless a file is given with --output.
+
+Supported formats:
+  todotxt   one todo.txt line per task
+  markdown  a "- [ ]" / "- [x]" checklist grouped by status, handy for pull requests
+  ics       an iCalendar file with one VTODO entry per task
+  csv       a CSV file with a header row, readable by "task-cli import --format csv"
+
+Example usage:
+  task-cli export --format markdown
+  task-cli export --format ics --output tasks.ics`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "" {
+			return newUsageError("Export format is required.")
+		}
+
+		output, _ := cmd.Flags().GetString("output")
+
+		var w io.Writer = cmd.OutOrStdout()
+		if output != "" {
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			w = file
+		}
+
+		if err := tasks.ExportTasks(format, w); err != nil {
+			return err
+		}
+
+		if output != "" {
+			cmd.Printf("Tasks exported to %s.\n", output)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringP("format", "f", "", "Output format: todotxt, markdown, ics or csv")
+	exportCmd.Flags().StringP("output", "o", "", "Write to this file instead of standard output")
+}
@@ -0,0 +1,210 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	taskdomain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/terminal"
+	"github.com/spf13/cobra"
+)
+
+const clearLine = "\r\033[K"
+
+var focusCmd = &cobra.Command{
+	Use:   "focus <id>",
+	Short: "Work on a task in timed focus sessions",
+	Long: `Run a pomodoro style focus session on a task: a countdown in the terminal, after which
+the session is logged as a time entry on the task. The task is marked as in progress when
+the first session starts.
+
+With --sessions several focus sessions run in a row with breaks in between, a long break
+after every --long-break-every sessions. Ctrl+C stops the cycle, a focus session stopped
+early is not logged.
+
+The task can be referenced by its numeric ID or by a unique prefix of its UUID.
+
+Example usage:
+  task-cli focus 1
+  task-cli focus 1 --minutes 50 --break 10
+  task-cli focus 3f2a9c --sessions 4
+  task-cli focus stats`,
+	ValidArgsFunction: completeTaskIds(taskdomain.Done),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return newUsageError("Only Task ID is required.")
+		}
+
+		plan, sessions, err := focusPlan(cmd)
+		if err != nil {
+			return err
+		}
+
+		id, err := tasks.ResolveTaskId(args[0])
+		if err != nil {
+			return err
+		}
+
+		task, err := tasks.StartFocus(id)
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		file, ok := cmd.OutOrStdout().(*os.File)
+		redraw := ok && terminal.IsTerminal(file.Fd())
+
+		for session := 1; session <= sessions; session++ {
+			cmd.Printf("Focus session %d of %d on task %d (%s), %s. Press Ctrl+C to stop.\n",
+				session, sessions, task.Id, task.Description, formatMinutes(plan.Focus))
+
+			startedAt := time.Now()
+
+			if !countdown(ctx, cmd, "Focus", plan.Focus, redraw) {
+				cmd.Printf("Focus session stopped after %s, it was not logged.\n", formatMinutes(time.Since(startedAt)))
+				return nil
+			}
+
+			if err := tasks.LogFocusSession(task.Id, startedAt, time.Now()); err != nil {
+				return err
+			}
+
+			cmd.Printf("Focus session %d done, %s logged on task %d.\n", session, formatMinutes(plan.Focus), task.Id)
+
+			if session == sessions {
+				break
+			}
+
+			duration, long := plan.BreakAfter(session)
+			label := "Break"
+			if long {
+				label = "Long break"
+			}
+
+			cmd.Printf("%s of %s.\n", label, formatMinutes(duration))
+
+			if !countdown(ctx, cmd, label, duration, redraw) {
+				cmd.Println("Stopped during the break.")
+				return nil
+			}
+		}
+
+		return nil
+	},
+}
+
+var focusStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Summarise the logged focus sessions",
+	Long: `Show the number of focus sessions and the focus time of today, this week and overall,
+and the focus time of every task this week.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		res, err := tasks.GetFocusStats()
+
+		if err != nil {
+			return err
+		}
+
+		cmd.Print(res)
+		return nil
+	},
+}
+
+// focusPlan reads the cycle of sessions and breaks from the flags.
+func focusPlan(cmd *cobra.Command) (tasks.FocusPlan, int, error) {
+	durations := map[string]time.Duration{}
+
+	for _, flag := range []string{"minutes", "break", "long-break"} {
+		minutes, _ := cmd.Flags().GetInt(flag)
+
+		if minutes <= 0 {
+			return tasks.FocusPlan{}, 0, newUsageError("--%s must be a positive number of minutes.", flag)
+		}
+
+		durations[flag] = time.Duration(minutes) * time.Minute
+	}
+
+	sessions, _ := cmd.Flags().GetInt("sessions")
+	longBreakEvery, _ := cmd.Flags().GetInt("long-break-every")
+
+	if sessions <= 0 || longBreakEvery <= 0 {
+		return tasks.FocusPlan{}, 0, newUsageError("--sessions and --long-break-every must be positive.")
+	}
+
+	plan := tasks.FocusPlan{
+		Focus:          durations["minutes"],
+		ShortBreak:     durations["break"],
+		LongBreak:      durations["long-break"],
+		LongBreakEvery: longBreakEvery,
+	}
+
+	return plan, sessions, nil
+}
+
+// countdown waits for d and reports whether it ran out rather than being cancelled.
+// On a terminal the remaining time is shown in place and a bell rings at the end.
+func countdown(ctx context.Context, cmd *cobra.Command, label string, d time.Duration, redraw bool) bool {
+	end := time.Now().Add(d)
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		if redraw {
+			cmd.Printf("%s%s %s", clearLine, label, formatCountdown(time.Until(end)))
+		}
+
+		select {
+		case <-ctx.Done():
+			if redraw {
+				cmd.Println()
+			}
+
+			return false
+		case <-timer.C:
+			if redraw {
+				cmd.Print(clearLine + "\a")
+			}
+
+			return true
+		case <-ticker.C:
+		}
+	}
+}
+
+// formatCountdown renders the remaining time as mm:ss, rounded up so that 00:00 is only shown at the end.
+func formatCountdown(d time.Duration) string {
+	seconds := int((d + time.Second - 1) / time.Second)
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
+
+func formatMinutes(d time.Duration) string {
+	return fmt.Sprintf("%dm", int(d/time.Minute))
+}
+
+func init() {
+	rootCmd.AddCommand(focusCmd)
+
+	focusCmd.AddCommand(focusStatsCmd)
+
+	focusCmd.Flags().Int("minutes", int(tasks.DefaultFocusPlan.Focus/time.Minute), "Length of a focus session in minutes")
+	focusCmd.Flags().Int("break", int(tasks.DefaultFocusPlan.ShortBreak/time.Minute), "Length of a short break in minutes")
+	focusCmd.Flags().Int("long-break", int(tasks.DefaultFocusPlan.LongBreak/time.Minute), "Length of a long break in minutes")
+	focusCmd.Flags().Int("sessions", 1, "Number of focus sessions to run in a row")
+	focusCmd.Flags().Int("long-break-every", tasks.DefaultFocusPlan.LongBreakEvery, "Number of focus sessions after which the break is a long one")
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import tasks from todo.txt, Taskwarrior JSON or CSV",
+	Long: `Read tasks from another tool and add them to your task list.
+Every imported task gets a fresh ID. Priorities, due dates, statuses and
+creation dates are taken over where the source format provides them.
+Lines that can not be imported are skipped and reported with their line number.
+
+Supported formats:
+  todotxt      todo.txt lines, e.g. "(A) 2025-09-01 Call mom due:2025-09-05"
+  taskwarrior  the JSON written by "task export"
+  csv          a header row with a description column and optional
+               status, priority, due, created and completed columns
+
+Use "-" as the file name to read from standard input.
+
+Example usage:
+  task-cli import --format todotxt todo.txt
+  task-cli import --format csv --dry-run tasks.csv
+  task export | task-cli import --format taskwarrior -`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return newUsageError("Only the file to import is required.")
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format == "" {
+			return newUsageError("Import format is required.")
+		}
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		var input io.Reader = cmd.InOrStdin()
+		if args[0] != "-" {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			input = file
+		}
+
+		res, err := tasks.ImportTasks(format, input, dryRun)
+
+		if err != nil {
+			return err
+		}
+
+		cmd.Print(res)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+
+	importCmd.Flags().StringP("format", "f", "", "Input format: todotxt, taskwarrior or csv")
+	importCmd.Flags().Bool("dry-run", false, "Show what would be imported without saving anything")
+}
@@ -5,21 +5,35 @@ package cmd
 
 import (
 	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/config"
 	taskdomain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/terminal"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/watch"
 	"github.com/spf13/cobra"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 )
 
+// allFilter lists tasks of every status.
+const allFilter = "all"
+
+const clearScreen = "\033[H\033[2J"
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List tasks, optionally filtered by status",
 	Long: `Display tasks from the JSON storage. 
-If no status argument is provided, all tasks are listed. 
-You can optionally filter tasks by status: "done", "todo", or "in-progress".
+If no status argument is provided, all tasks are listed, or the status set as
+list.default_filter in the configuration.
+You can optionally filter tasks by status: "done", "todo", or "in-progress", or use "all".
 
 Example usage:
   # List all tasks
   task-cli list
+  task-cli list all
 
   # List only completed tasks
   task-cli list done
@@ -28,51 +42,175 @@ Example usage:
   task-cli list todo
 
   # List tasks currently in progress
-  task-cli list in-progress`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if len(args) == 0 {
-			res, err := tasks.GetAllTasks()
+  task-cli list in-progress
+
+  # List archived tasks
+  task-cli list --archived
+
+  # List the tasks assigned to you or to someone else
+  task-cli list --mine
+  task-cli list todo --assignee bob
+
+  # Keep the list on screen and show it again whenever the tasks change
+  task-cli list --watch
+  task-cli list todo --watch --poll   # e.g. on a network file system`,
+	ValidArgsFunction: completeListFilter,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		archived, _ := cmd.Flags().GetBool("archived")
+		watching, _ := cmd.Flags().GetBool("watch")
+
+		if !archived && len(args) == 0 {
+			args = []string{appConfig.Value(config.DefaultListFilter)}
+		}
+
+		assignee, err := listAssignee(cmd)
+
+		if err != nil {
+			return err
+		}
+
+		list, err := taskLister(archived, assignee, args)
+
+		if err != nil {
+			return err
+		}
+
+		if !watching {
+			res, err := list()
 
 			if err != nil {
-				cmd.Printf("Error: %s\n", err.Error())
-			} else {
-				cmd.Print(res)
-			}
-		} else if len(args) == 1 {
-			progressStr := strings.ToLower(args[0])
-			possibleProgressStrs := map[string]interface{}{
-				taskdomain.TodoStr:       struct{}{},
-				taskdomain.InProgressStr: struct{}{},
-				taskdomain.DoneStr:       struct{}{},
+				return err
 			}
 
-			if _, ok := possibleProgressStrs[progressStr]; !ok {
-				cmd.Printf("Error: Invalid progress filter. Use '%s', '%s', or '%s'.\n",
-					taskdomain.TodoStr, taskdomain.InProgressStr, taskdomain.DoneStr)
-				return
-			} else {
-				progress, err := tasks.ParseStatusString(progressStr)
-
-				if err != nil {
-					cmd.Printf("Error: %s\n", err.Error())
-					return
-				}
-
-				res, err := tasks.GetTasks(progress)
-
-				if err != nil {
-					cmd.Printf("Error: %s\n", err.Error())
-				} else {
-					cmd.Print(res)
-				}
-			}
+			cmd.Print(res)
+			return nil
+		}
 
-		} else {
-			cmd.Println("Error: No arguments or only progress filter are required.")
+		path := tasks.TasksFilePath()
+		if archived {
+			path = tasks.ArchiveFilePath()
 		}
+
+		poll, _ := cmd.Flags().GetBool("poll")
+		return watchList(cmd, path, poll, list)
 	},
 }
 
+// listAssignee returns the user whose tasks are listed, empty for the tasks of everybody.
+func listAssignee(cmd *cobra.Command) (string, error) {
+	mine, _ := cmd.Flags().GetBool("mine")
+	assignee, _ := cmd.Flags().GetString("assignee")
+
+	if !mine {
+		return strings.TrimSpace(assignee), nil
+	}
+
+	if assignee != "" {
+		return "", newUsageError("Use either --mine or --assignee.")
+	}
+
+	if tasks.CurrentUser() == "" {
+		return "", newUsageError("Your user name is unknown, set it with: task-cli config set %s <name>", config.UserName)
+	}
+
+	return tasks.CurrentUser(), nil
+}
+
+// taskLister checks the arguments of list and returns the function producing the listing.
+func taskLister(archived bool, assignee string, args []string) (func() (string, error), error) {
+	if archived {
+		if len(args) != 0 {
+			return nil, newUsageError("Archived tasks can not be filtered by progress.")
+		}
+
+		if assignee != "" {
+			return nil, newUsageError("Archived tasks can not be filtered by assignee.")
+		}
+
+		return tasks.GetArchivedTasks, nil
+	}
+
+	if len(args) != 1 {
+		return nil, newUsageError("No arguments or only progress filter are required.")
+	}
+
+	progressStr := strings.ToLower(args[0])
+
+	if progressStr == allFilter {
+		if assignee != "" {
+			return func() (string, error) {
+				return tasks.GetAllAssignedTasks(assignee)
+			}, nil
+		}
+
+		return tasks.GetAllTasks, nil
+	}
+
+	possibleProgressStrs := map[string]interface{}{
+		taskdomain.TodoStr:       struct{}{},
+		taskdomain.InProgressStr: struct{}{},
+		taskdomain.DoneStr:       struct{}{},
+	}
+
+	if _, ok := possibleProgressStrs[progressStr]; !ok {
+		return nil, newUsageError("Invalid progress filter. Use '%s', '%s', '%s' or '%s'.",
+			allFilter, taskdomain.TodoStr, taskdomain.InProgressStr, taskdomain.DoneStr)
+	}
+
+	progress, err := tasks.ParseStatusString(progressStr)
+
+	if err != nil {
+		return nil, &usageError{message: err.Error()}
+	}
+
+	return func() (string, error) {
+		if assignee != "" {
+			return tasks.GetAssignedTasks(assignee, progress)
+		}
+
+		return tasks.GetTasks(progress)
+	}, nil
+}
+
+// watchList prints the listing again whenever the file at path changes, until Ctrl+C.
+// On a terminal the screen is cleared first, so the listing stays in place.
+func watchList(cmd *cobra.Command, path string, poll bool, list func() (string, error)) error {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	changes := watch.File(ctx, path, watch.Options{Poll: poll})
+	file, ok := cmd.OutOrStdout().(*os.File)
+	redraw := ok && terminal.IsTerminal(file.Fd())
+
+	for {
+		res, err := list()
+
+		if redraw {
+			cmd.Print(clearScreen)
+		}
+
+		cmd.Printf("Watching %s, press Ctrl+C to stop. Updated %s.\n\n", path, time.Now().Format("15:04:05"))
+
+		// A failed listing is shown instead of ending the watch, e.g. while another command rewrites the file.
+		if err != nil {
+			cmd.PrintErrf("Error: %s\n", err.Error())
+		} else {
+			cmd.Print(res)
+		}
+
+		if _, ok := <-changes; !ok {
+			return nil
+		}
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(listCmd)
+
+	listCmd.Flags().Bool("archived", false, "List archived tasks instead of active ones")
+	listCmd.Flags().BoolP("watch", "w", false, "Show the list again whenever the tasks change, until Ctrl+C")
+	listCmd.Flags().Bool("poll", false, "With --watch, check the task file periodically instead of using file system events")
+	listCmd.Flags().Bool("mine", false, "List only the tasks assigned to you")
+	listCmd.Flags().String("assignee", "", "List only the tasks assigned to this user")
+	_ = listCmd.RegisterFlagCompletionFunc("assignee", completeUsers)
 }
@@ -4,7 +4,6 @@ Copyright © 2025 LEXVOLK
 package cmd
 
 import (
-	"fmt"
 	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
 	taskdomain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
 	"github.com/spf13/cobra"
@@ -17,27 +16,28 @@ var markDoneCmd = &cobra.Command{
 	Long: `Change the status of a task to "done".
 This helps you keep track of completed tasks.
 
+The task can be referenced by its numeric ID or by a unique prefix of its UUID.
+
 Example usage:
-  task-cli mark-done 1`,
-	Run: func(cmd *cobra.Command, args []string) {
+  task-cli mark-done 1
+  task-cli mark-done 3f2a9c`,
+	ValidArgsFunction: completeTaskIds(taskdomain.Done),
+	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
-			cmd.Println("Error: Only Task ID is required.")
-			return
+			return newUsageError("Only Task ID is required.")
 		}
 
-		var id int
-		_, err := fmt.Sscanf(args[0], "%d", &id)
+		id, err := tasks.ResolveTaskId(args[0])
 		if err != nil {
-			cmd.Println("Error: Invalid task ID format.")
-			return
+			return err
 		}
 
-		err = tasks.UpdateTaskStatus(id, taskdomain.Done)
-		if err != nil {
-			cmd.Printf("Error: %s\n", err.Error())
-		} else {
-			cmd.Println("Task marked as done successfully.")
+		if err := tasks.UpdateTaskStatus(id, taskdomain.Done); err != nil {
+			return err
 		}
+
+		cmd.Println("Task marked as done successfully.")
+		return nil
 	},
 }
 
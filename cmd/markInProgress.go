@@ -4,7 +4,6 @@ Copyright © 2025 LEXVOLK
 package cmd
 
 import (
-	"fmt"
 	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
 	taskdomain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
 	"github.com/spf13/cobra"
@@ -17,27 +16,27 @@ var markInProgressCmd = &cobra.Command{
 	Long: `Change the status of a task to "in progress".
 This is useful to track tasks that are currently being worked on.
 
+The task can be referenced by its numeric ID or by a unique prefix of its UUID.
+
 Example usage:
   task-cli mark-in-progress 1`,
-	Run: func(cmd *cobra.Command, args []string) {
+	ValidArgsFunction: completeTaskIds(taskdomain.InProgress),
+	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) != 1 {
-			cmd.Println("Error: Only Task ID is required.")
-			return
+			return newUsageError("Only Task ID is required.")
 		}
 
-		var id int
-		_, err := fmt.Sscanf(args[0], "%d", &id)
+		id, err := tasks.ResolveTaskId(args[0])
 		if err != nil {
-			cmd.Println("Error: Invalid task ID format.")
-			return
+			return err
 		}
 
-		err = tasks.UpdateTaskStatus(id, taskdomain.InProgress)
-		if err != nil {
-			cmd.Printf("Error: %s\n", err.Error())
-		} else {
-			cmd.Println("Task marked as in progress successfully.")
+		if err := tasks.UpdateTaskStatus(id, taskdomain.InProgress); err != nil {
+			return err
 		}
+
+		cmd.Println("Task marked as in progress successfully.")
+		return nil
 	},
 }
 
@@ -0,0 +1,54 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var mergeDriverCmd = &cobra.Command{
+	Use:   "merge-driver <base> <ours> <theirs>",
+	Short: "Merge two versions of tasks.json (git merge driver)",
+	Long: `Merge the task lists in <ours> and <theirs>, which both started from <base>, and write
+the result to <ours>. This is the interface git uses for custom merge drivers.
+
+Tasks are matched by UUID and merged field by field. When both sides changed the same field,
+the change with the later update time wins. Deleted tasks stay deleted unless the other side
+changed them. The result is always valid JSON; if fields conflicted they are listed and the
+command exits with status 1 so that git marks the file as conflicted for review.
+
+Register the driver in a repository that holds tasks.json:
+  git config merge.task-cli.name "task-cli task list merge"
+  git config merge.task-cli.driver "task-cli merge-driver %O %A %B"
+  echo "tasks.json merge=task-cli" >> .gitattributes
+  echo "archive.json merge=task-cli" >> .gitattributes`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 3 {
+			return newUsageError("Base, ours and theirs files are required.")
+		}
+
+		conflicts, err := tasks.MergeTaskFiles(args[0], args[1], args[2])
+		if err != nil {
+			return err
+		}
+
+		unresolved := false
+		for _, conflict := range conflicts {
+			cmd.PrintErrf("%s\n", conflict.String())
+			unresolved = unresolved || !conflict.IsRenumbering()
+		}
+
+		// Git treats a non-zero exit status of a merge driver as a conflict.
+		if unresolved {
+			return &exitError{code: exitFailure}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(mergeDriverCmd)
+}
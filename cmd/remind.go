@@ -0,0 +1,80 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"time"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	taskdomain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/spf13/cobra"
+)
+
+var remindCmd = &cobra.Command{
+	Use:   "remind <id>",
+	Short: "Set a reminder for a task",
+	Long: `Store a reminder on a task. Reminders are delivered by "task-cli daemon".
+
+The time can be relative ("in 30m", "in 2 hours", "in 3d"), a time of day ("9am", "14:30",
+today or tomorrow when it has passed), a day with an optional time ("tomorrow 9am",
+"friday 5pm", "today") or a date ("2025-10-01", "2025-10-01 14:30").
+Days without a time remind at 9:00.
+
+The task can be referenced by its numeric ID or by a unique prefix of its UUID.
+
+Example usage:
+  task-cli remind 1 --at "tomorrow 9am"
+  task-cli remind 3f2a9c --at "in 2h"
+  task-cli remind 1 --clear`,
+	ValidArgsFunction: completeTaskIds(taskdomain.Done),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return newUsageError("Only Task ID is required.")
+		}
+
+		at, _ := cmd.Flags().GetString("at")
+		remove, _ := cmd.Flags().GetBool("clear")
+
+		if (at == "") == !remove {
+			return newUsageError("Either --at or --clear is required.")
+		}
+
+		id, err := tasks.ResolveTaskId(args[0])
+		if err != nil {
+			return err
+		}
+
+		var remindAt time.Time
+		if !remove {
+			now := tasks.Now()
+
+			if remindAt, err = tasks.ParseReminderTime(at, now); err != nil {
+				return &usageError{message: err.Error()}
+			}
+
+			if remindAt.Before(now) {
+				return newUsageError("The reminder time is in the past (%s).", tasks.FormatDate(remindAt))
+			}
+		}
+
+		if err := tasks.SetReminder(id, remindAt); err != nil {
+			return err
+		}
+
+		if remove {
+			cmd.Println("Reminder removed.")
+		} else {
+			cmd.Printf("Reminder set: %s.\n", tasks.FormatDate(remindAt))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(remindCmd)
+
+	remindCmd.Flags().String("at", "", "When to be reminded, e.g. \"tomorrow 9am\" or \"in 30m\"")
+	remindCmd.Flags().Bool("clear", false, "Remove the reminder of the task")
+}
@@ -4,11 +4,20 @@ Copyright © 2025 LEXVOLK
 package cmd
 
 import (
+	"errors"
+	"io"
 	"os"
 
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/config"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/files"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
 	"github.com/spf13/cobra"
 )
 
+// appConfig is the configuration loaded before every command runs.
+var appConfig *config.Config
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "TaskTracker",
@@ -17,25 +26,112 @@ var rootCmd = &cobra.Command{
 The application stores all tasks in a JSON file, making it lightweight and easy to use without requiring a database.
 Users can perform essential task operations directly from the command line, including adding new tasks, updating existing ones, deleting tasks, and changing task statuses to “in progress” or “done.” The application also provides flexible listing options, allowing users to view all tasks, only completed tasks, only pending tasks, or tasks currently in progress.
 With simple arguments and commands, this CLI tool is perfect for anyone who wants a fast, lightweight, and easy-to-use task manager without leaving the terminal.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// The location of a broken file must still be shown.
+		if cmd == configPathCmd {
+			return nil
+		}
+
+		path, err := configFilePath(cmd)
+		if err != nil {
+			return err
+		}
+
+		loaded, err := config.Load(path)
+		appConfig = loaded
+
+		// The config commands keep working with invalid values so that they can be fixed.
+		if err != nil && (loaded == nil || !isConfigCommand(cmd)) {
+			return err
+		}
+
+		files.SetSaveDir(appConfig.StorageDir())
+		tasks.SetDateLayout(appConfig.Value(config.DateFormat))
+		tasks.SetTimeZone(appConfig.Location())
+		tasks.SetRelativeDates(appConfig.Value(config.RelativeDates) == "true")
+		tasks.SetCurrentUser(appConfig.UserName())
+		tasks.SetWebhooks(appConfig.Webhooks())
+
+		r, err := outputRenderer(cmd)
+		if err != nil {
+			return err
+		}
+
+		tasks.SetRenderer(r)
+		return nil
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	err := rootCmd.Execute()
+	if code := run(os.Args[1:], os.Stdout, os.Stderr); code != exitOK {
+		os.Exit(code)
+	}
+}
+
+// run executes the command line args, writing regular output to stdout and errors to stderr,
+// and returns the exit status.
+func run(args []string, stdout, stderr io.Writer) int {
+	rootCmd.SetArgs(args)
+	rootCmd.SetOut(stdout)
+	rootCmd.SetErr(stderr)
+
+	cmd, err := rootCmd.ExecuteC()
+	if err == nil {
+		return exitOK
+	}
+
+	// The root command has no action of its own, its only error is an unknown subcommand.
+	if cmd == rootCmd {
+		err = &usageError{message: err.Error()}
+	}
+
+	var exitErr *exitError
+	if !errors.As(err, &exitErr) {
+		cmd.PrintErrf("Error: %s\n", err.Error())
+	}
+
+	return exitCode(err)
+}
+
+// configFilePath returns the configuration file given with --config or the default one.
+func configFilePath(cmd *cobra.Command) (string, error) {
+	if path, _ := cmd.Flags().GetString("config"); path != "" {
+		return path, nil
+	}
+
+	return config.DefaultPath()
+}
+
+// outputRenderer colors the command output as asked by --color, NO_COLOR and display.color, in that order.
+func outputRenderer(cmd *cobra.Command) (*renderer.Renderer, error) {
+	value := appConfig.Value(config.Color)
+	if os.Getenv(renderer.NoColorEnv) != "" {
+		value = string(renderer.Never)
+	}
+
+	if flag := cmd.Flags().Lookup("color"); flag != nil && flag.Changed {
+		value = flag.Value.String()
+	}
+
+	mode, err := renderer.ParseMode(value)
 	if err != nil {
-		os.Exit(1)
+		return nil, &usageError{message: err.Error()}
 	}
+
+	colors := renderer.UseColors(mode, cmd.OutOrStderr(), os.Getenv)
+	return renderer.New(colors, appConfig.Theme()), nil
 }
 
 func init() {
-	// Here you will define your flags and configuration settings.
-	// Cobra supports persistent flags, which, if defined here,
-	// will be global for your application.
-
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.TaskTracker.yaml)")
+	// Errors are printed once by run, and only usage mistakes would get the usage text otherwise.
+	rootCmd.SilenceErrors = true
+	rootCmd.SilenceUsage = true
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return &usageError{message: err.Error()}
+	})
 
-	// Cobra also supports local flags, which will only run
-	// when this action is called directly.
-	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	rootCmd.PersistentFlags().String("config", "", "config file (default is $XDG_CONFIG_HOME/TaskTracker-CLI/config.json)")
+	rootCmd.PersistentFlags().String("color", string(renderer.Auto), "when to color the output: auto, always or never")
 }
@@ -0,0 +1,46 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Find tasks by text",
+	Long: `Search task descriptions for the given terms.
+Matching is case-insensitive and token based: every term has to occur in a task for it to match.
+Whole-word matches rank above word prefixes, which rank above matches inside a word.
+Matches are highlighted in the output.
+
+With --regex the terms are joined with spaces and used as a single regular expression.
+
+Example usage:
+  task-cli search groceries
+  task-cli search buy milk
+  task-cli search --regex "^buy (milk|bread)"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return newUsageError("Search terms are required.")
+		}
+
+		regex, _ := cmd.Flags().GetBool("regex")
+		res, err := tasks.SearchTasks(args, regex)
+
+		if err != nil {
+			return err
+		}
+
+		cmd.Print(res)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(searchCmd)
+
+	searchCmd.Flags().BoolP("regex", "r", false, "Treat the search terms as a regular expression")
+}
@@ -0,0 +1,94 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/server"
+	"github.com/spf13/cobra"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the tasks over a local HTTP JSON API",
+	Long: `Start a local HTTP server exposing the tasks as a JSON REST API.
+
+Endpoints:
+  GET    /tasks         list tasks, filtered by ?status=, ?tag=, ?project= and ?q=
+  POST   /tasks         add a task, body: {"description": "..."}
+  GET    /tasks/{id}    show a task by ID or UUID prefix
+  PATCH  /tasks/{id}    change the description and/or status, body: {"description": "...", "status": "done"}
+  DELETE /tasks/{id}    delete a task
+
+Request bodies must be sent with Content-Type: application/json, others are answered with 415.
+Missing tasks are answered with 404, invalid input with 400 and changes rejected by a hook
+script with 422, errors come as {"error": "..."}.
+The server stops on Ctrl+C.
+
+Example usage:
+  task-cli serve
+  task-cli serve --addr 127.0.0.1:9090`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		addr, _ := cmd.Flags().GetString("addr")
+
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			return err
+		}
+
+		service := tasks.DefaultService()
+		// Closed after the server has finished the requests, so that their webhooks are still delivered.
+		defer service.Close()
+
+		httpServer := &http.Server{
+			Handler:           server.NewHandler(service),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		shutdown := make(chan struct{})
+
+		go func() {
+			defer close(shutdown)
+			<-ctx.Done()
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+
+			_ = httpServer.Shutdown(shutdownCtx)
+		}()
+
+		cmd.Printf("Listening on http://%s\n", listener.Addr().String())
+
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+
+		<-shutdown
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	serveCmd.Flags().String("addr", "127.0.0.1:8080", "Address to listen on")
+}
@@ -0,0 +1,46 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var showCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show the details and comments of a task",
+	Long: `Display every field of a task, such as its assignee, due date and logged time,
+followed by its comments.
+
+The task can be referenced by its numeric ID or by a unique prefix of its UUID.
+
+Example usage:
+  task-cli show 1
+  task-cli show 3f2a9c`,
+	ValidArgsFunction: completeTaskIds(),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return newUsageError("Only Task ID is required.")
+		}
+
+		id, err := tasks.ResolveTaskId(args[0])
+		if err != nil {
+			return err
+		}
+
+		res, err := tasks.GetTaskDetails(id)
+
+		if err != nil {
+			return err
+		}
+
+		cmd.Print(res)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(showCmd)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"time"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show task statistics and a burndown chart",
+	Long: `Summarise the task list: the number of tasks per status, tasks created and
+completed per week, the average lead time from creation to completion, the oldest
+open tasks and an ASCII burndown chart of open tasks per day.
+
+The weekly summary and the burndown cover the last four weeks unless a date range
+is given with --from and --to (format YYYY-MM-DD, both inclusive). The range may
+span at most 366 days.
+
+Example usage:
+  task-cli stats
+  task-cli stats --from 2025-09-01 --to 2025-09-30`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		from, err := parseStatsDate(cmd, "from")
+		if err != nil {
+			return newUsageError("Invalid --from date, expected YYYY-MM-DD.")
+		}
+
+		to, err := parseStatsDate(cmd, "to")
+		if err != nil {
+			return newUsageError("Invalid --to date, expected YYYY-MM-DD.")
+		}
+
+		res, err := tasks.GetStats(from, to)
+
+		if err != nil {
+			return err
+		}
+
+		cmd.Print(res)
+		return nil
+	},
+}
+
+func parseStatsDate(cmd *cobra.Command, flag string) (time.Time, error) {
+	value, _ := cmd.Flags().GetString(flag)
+
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.ParseInLocation(tasks.StatsDateLayout, value, appConfig.Location())
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+
+	statsCmd.Flags().String("from", "", "First day of the reported range (YYYY-MM-DD)")
+	statsCmd.Flags().String("to", "", "Last day of the reported range (YYYY-MM-DD)")
+}
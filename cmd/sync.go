@@ -0,0 +1,67 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Synchronize the tasks with a git remote",
+	Long: `Synchronize the task store with other machines through a git remote.
+
+On the first sync the directory holding tasks.json becomes a git repository. From then on
+every change made with task-cli is committed with a descriptive message. Sync pulls the
+changes from the remote, merges tasks.json task by task instead of line by line and pushes
+the result. Changes made to the same field on both machines are reported; the newer one is kept.
+
+The remote can be any git URL or path, for example a bare repository on a shared drive.
+It only has to be given once with --remote.
+
+Example usage:
+  task-cli sync --remote git@example.com:me/tasks.git
+  task-cli sync`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		remote, _ := cmd.Flags().GetString("remote")
+
+		report, err := tasks.SyncTasks(remote)
+
+		if report.Initialized {
+			cmd.Println("Initialized a git repository for the tasks.")
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, conflict := range report.Conflicts {
+			cmd.Printf("Conflict: %s\n", conflict.String())
+		}
+
+		switch {
+		case report.Merged:
+			cmd.Println("Merged changes from the remote and pushed the result.")
+		case report.Pulled:
+			cmd.Println("Pulled changes from the remote.")
+		case report.Pushed:
+			cmd.Println("Pushed local changes to the remote.")
+		default:
+			cmd.Println("Already up to date.")
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(syncCmd)
+
+	syncCmd.Flags().String("remote", "", "Git URL or path of the remote to sync with")
+}
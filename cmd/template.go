@@ -0,0 +1,163 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"strings"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	taskdomain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/spf13/cobra"
+)
+
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Save groups of tasks as templates and create them again",
+	Long: `Save a group of tasks, e.g. a release checklist, as a template and create all of
+its tasks again with a single command.
+
+Templates are JSON files in the templates directory next to tasks.json and can be edited
+by hand. Every task of a template has a description, optional tags and priority and an
+optional due date relative to the moment the template is applied, e.g. "3d" or "12h".
+Descriptions and tags may contain placeholders like {{version}}, which are filled in with
+--var when the template is applied.
+
+Example usage:
+  task-cli template save release --from-tag release-checklist --var version=1.3
+  task-cli template apply release --var version=1.4
+  task-cli template list
+  task-cli template delete release`,
+}
+
+var templateSaveCmd = &cobra.Command{
+	Use:   "save <name> --from-tag <tag>",
+	Short: "Save the tasks carrying a tag as a template",
+	Long: `Save the tasks carrying a #tag as the template <name>, replacing a template of the same name.
+
+The tag selecting the tasks is left out of the template. Due dates are saved relative to
+the creation of their task. Every --var name=value turns the value into the placeholder
+{{name}}, so "Tag v1.3" saved with --var version=1.3 becomes "Tag v{{version}}".`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return newUsageError("Only the template name is required.")
+		}
+
+		tag, _ := cmd.Flags().GetString("from-tag")
+
+		if strings.TrimSpace(tag) == "" {
+			return newUsageError("The tag of the tasks is required, use --from-tag.")
+		}
+
+		vars, err := templateVars(cmd)
+		if err != nil {
+			return err
+		}
+
+		template, err := tasks.SaveTemplate(args[0], tag, vars)
+
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Template %s saved with %d tasks.\n", template.Name, len(template.Tasks))
+		return nil
+	},
+}
+
+var templateApplyCmd = &cobra.Command{
+	Use:               "apply <name>",
+	Short:             "Create the tasks of a template",
+	ValidArgsFunction: completeTemplateNames,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return newUsageError("Only the template name is required.")
+		}
+
+		vars, err := templateVars(cmd)
+		if err != nil {
+			return err
+		}
+
+		created, err := tasks.ApplyTemplate(args[0], vars)
+
+		if err != nil {
+			return err
+		}
+
+		for _, task := range created {
+			cmd.Printf("Task added successfully (ID: %d, UUID: %s): %s\n", task.Id, task.Uuid, task.Description)
+		}
+
+		return nil
+	},
+}
+
+var templateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the saved templates",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		names, err := tasks.ListTemplates()
+
+		if err != nil {
+			return err
+		}
+
+		if len(names) == 0 {
+			cmd.Println("No templates saved yet.")
+		}
+
+		for _, name := range names {
+			cmd.Println(name)
+		}
+
+		return nil
+	},
+}
+
+var templateDeleteCmd = &cobra.Command{
+	Use:               "delete <name>",
+	Short:             "Delete a template",
+	ValidArgsFunction: completeTemplateNames,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return newUsageError("Only the template name is required.")
+		}
+
+		if err := tasks.DeleteTemplate(args[0]); err != nil {
+			return err
+		}
+
+		cmd.Println("Template deleted successfully.")
+		return nil
+	},
+}
+
+func templateVars(cmd *cobra.Command) (map[string]string, error) {
+	values, _ := cmd.Flags().GetStringArray("var")
+	vars, err := tasks.ParseTemplateVars(values)
+
+	if err != nil {
+		return nil, &usageError{message: err.Error()}
+	}
+
+	return vars, nil
+}
+
+func init() {
+	rootCmd.AddCommand(templateCmd)
+
+	templateCmd.AddCommand(templateSaveCmd)
+	templateCmd.AddCommand(templateApplyCmd)
+	templateCmd.AddCommand(templateListCmd)
+	templateCmd.AddCommand(templateDeleteCmd)
+
+	templateSaveCmd.Flags().String("from-tag", "", "Tag of the tasks to save, with or without #")
+	templateSaveCmd.Flags().StringArray("var", nil, "Variable as name=value whose value becomes a {{name}} placeholder, can be repeated")
+	templateApplyCmd.Flags().StringArray("var", nil, "Value of a placeholder as name=value, can be repeated")
+	_ = templateSaveCmd.RegisterFlagCompletionFunc("from-tag", completeMarkers(taskdomain.Task.Tags))
+}
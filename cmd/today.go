@@ -0,0 +1,40 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/spf13/cobra"
+)
+
+var todayCmd = &cobra.Command{
+	Use:   "today",
+	Short: "Show what needs attention today",
+	Long: `Show the tasks that matter today, in sections: overdue tasks, tasks due today,
+tasks in progress and tasks completed since midnight.
+
+A task is listed only in its first section, so an overdue task in progress appears
+as overdue. Days follow the local calendar.
+
+Example usage:
+  task-cli today`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		res, err := tasks.GetToday()
+
+		if err != nil {
+			return err
+		}
+
+		cmd.Print(res)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(todayCmd)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright © 2025 LEXVOLK
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/terminal"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Manage tasks in an interactive full-screen view",
+	Long: `Open a full-screen terminal interface with a navigable task table and a detail pane.
+Changes are made through the same operations as the regular commands.
+The view needs a Linux, macOS or FreeBSD terminal, it is not available on Windows yet.
+
+Key bindings:
+  ↑/↓, j/k        move the selection
+  PgUp/PgDn       move by ten tasks
+  /               filter as you type (Enter keeps the filter, Esc clears it)
+  a               add a task
+  e               edit the selected task's description
+  d               delete the selected task (asks for confirmation)
+  t, p, x         mark the selected task as todo, in progress or done
+  r               reload tasks from storage
+  q, Ctrl+C       quit
+
+Example usage:
+  task-cli tui`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 0 {
+			return newUsageError("No arguments are required.")
+		}
+
+		if !terminal.Supported {
+			return fmt.Errorf("the interactive view is not available on %s yet, use the list, add, update and mark commands instead", runtime.GOOS)
+		}
+
+		model, err := tui.NewModel(tui.Actions{
+			Load:         tasks.ListTasks,
+			Add:          tasks.AddTask,
+			Update:       tasks.UpdateTask,
+			UpdateStatus: tasks.UpdateTaskStatus,
+			Delete:       tasks.DeleteTask,
+			FormatDate:   tasks.FormatDate,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return tui.Run(os.Stdin, cmd.OutOrStdout(), model)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+}
@@ -4,7 +4,6 @@ Copyright © 2025 LEXVOLK
 package cmd
 
 import (
-	"fmt"
 	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
 
 	"github.com/spf13/cobra"
@@ -17,28 +16,28 @@ var updateCmd = &cobra.Command{
 	Long: `Modify the description of a task identified by its ID.
 This allows you to change the task details without creating a new task.
 
+The task can be referenced by its numeric ID or by a unique prefix of its UUID.
+
 Example usage:
   task-cli update 1 "Buy groceries and cook dinner"`,
-	Run: func(cmd *cobra.Command, args []string) {
+	ValidArgsFunction: completeTaskIds(),
+	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) < 2 {
-			cmd.Println("Error: Task ID and new description are required.")
-			return
+			return newUsageError("Task ID and new description are required.")
 		}
 
-		var id int
-		_, err := fmt.Sscanf(args[0], "%d", &id)
+		id, err := tasks.ResolveTaskId(args[0])
 		if err != nil {
-			cmd.Println("Error: Invalid task ID format.")
-			return
+			return err
 		}
 
 		newDescription := args[1]
-		err = tasks.UpdateTask(id, newDescription)
-		if err != nil {
-			cmd.Printf("Error: %s\n", err.Error())
-		} else {
-			cmd.Println("Task updated successfully.")
+		if err := tasks.UpdateTask(id, newDescription); err != nil {
+			return err
 		}
+
+		cmd.Println("Task updated successfully.")
+		return nil
 	},
 }
 
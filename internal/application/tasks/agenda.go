@@ -0,0 +1,217 @@
+package tasks
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultAgendaDays = 7
+	agendaDayLayout   = "Mon 2006-01-02"
+	agendaTimeLayout  = "15:04"
+)
+
+type agendaSection struct {
+	title string
+	tasks []domain.Task
+	// when renders the time shown next to a task of the section, nil shows none.
+	when func(task domain.Task) string
+}
+
+// GetToday renders the overdue tasks, the tasks due today, the tasks in progress and the tasks completed today.
+func GetToday() (string, error) {
+	return getToday(defaultTaskStorage, Now)
+}
+
+// GetAgenda renders the open tasks due in the next days, grouped by day and preceded by the overdue ones.
+func GetAgenda(days int) (string, error) {
+	return getAgenda(defaultTaskStorage, days, Now)
+}
+
+func getToday(storage domain.TaskStorage, now func() time.Time) (string, error) {
+	tasks, err := storage.Load()
+
+	if err != nil {
+		return "", err
+	}
+
+	return renderAgendaSections(buildToday(tasks, now()), taskRenderer.WithClock(now)), nil
+}
+
+func getAgenda(storage domain.TaskStorage, days int, now func() time.Time) (string, error) {
+	if days < 1 {
+		return "", newInvalidInputError("invalid number of days %d: expected at least 1", days)
+	}
+
+	tasks, err := storage.Load()
+
+	if err != nil {
+		return "", err
+	}
+
+	return renderAgendaSections(buildAgenda(tasks, days, now()), taskRenderer.WithClock(now)), nil
+}
+
+// buildToday sorts the tasks into the sections of the today view. A task is listed only in the first
+// section it belongs to, so an overdue task in progress is shown as overdue. Days are calendar days
+// in the location of now, which keeps them right on the days daylight saving time starts or ends.
+func buildToday(tasks []domain.Task, now time.Time) []agendaSection {
+	today := startOfDay(now)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	overdue := agendaSection{title: "Overdue", when: dueDate(now)}
+	dueToday := agendaSection{title: "Due today", when: dueTime(now.Location())}
+	inProgress := agendaSection{title: "In progress"}
+	completed := agendaSection{title: "Completed today", when: completedTime(now.Location())}
+
+	for _, task := range sortedByDue(tasks) {
+		if completedAt, ok := completionTime(task); ok {
+			if !completedAt.Before(today) {
+				completed.tasks = append(completed.tasks, task)
+			}
+
+			continue
+		}
+
+		switch {
+		case !task.DueAt.IsZero() && task.DueAt.Before(today):
+			overdue.tasks = append(overdue.tasks, task)
+		case inRange(task.DueAt, today, tomorrow):
+			dueToday.tasks = append(dueToday.tasks, task)
+		case task.CurrentStatus == domain.InProgress:
+			inProgress.tasks = append(inProgress.tasks, task)
+		}
+	}
+
+	return []agendaSection{overdue, dueToday, inProgress, completed}
+}
+
+// buildAgenda groups the open tasks due in the given number of days, starting today, by their day.
+// The overdue section is left out when nothing is overdue.
+func buildAgenda(tasks []domain.Task, days int, now time.Time) []agendaSection {
+	today := startOfDay(now)
+
+	overdue := agendaSection{title: "Overdue", when: dueDate(now)}
+	sections := make([]agendaSection, days)
+
+	for i := range sections {
+		day := today.AddDate(0, 0, i)
+		sections[i] = agendaSection{title: agendaDayTitle(day, i), when: dueTime(now.Location())}
+	}
+
+	end := today.AddDate(0, 0, days)
+
+	for _, task := range sortedByDue(tasks) {
+		if task.CurrentStatus == domain.Done || task.DueAt.IsZero() || !task.DueAt.Before(end) {
+			continue
+		}
+
+		if task.DueAt.Before(today) {
+			overdue.tasks = append(overdue.tasks, task)
+			continue
+		}
+
+		day := &sections[daysBetween(today, task.DueAt)]
+		day.tasks = append(day.tasks, task)
+	}
+
+	if len(overdue.tasks) == 0 {
+		return sections
+	}
+
+	return append([]agendaSection{overdue}, sections...)
+}
+
+func agendaDayTitle(day time.Time, offset int) string {
+	switch offset {
+	case 0:
+		return "Today, " + day.Format(agendaDayLayout)
+	case 1:
+		return "Tomorrow, " + day.Format(agendaDayLayout)
+	default:
+		return day.Format(agendaDayLayout)
+	}
+}
+
+// daysBetween counts the calendar days from the start of day to t. Days are counted on the calendar
+// rather than in 24 hour steps, because days with a daylight saving time change are shorter or longer.
+func daysBetween(day, t time.Time) int {
+	days := 0
+
+	for next := day.AddDate(0, 0, 1); !t.Before(next); next = next.AddDate(0, 0, 1) {
+		days++
+	}
+
+	return days
+}
+
+// sortedByDue returns a copy of tasks ordered by due time, tasks without one keep their order at the end.
+func sortedByDue(tasks []domain.Task) []domain.Task {
+	sorted := append([]domain.Task(nil), tasks...)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].DueAt, sorted[j].DueAt
+
+		if a.IsZero() || b.IsZero() {
+			return !a.IsZero() && b.IsZero()
+		}
+
+		return a.Before(b)
+	})
+
+	return sorted
+}
+
+func dueDate(now time.Time) func(domain.Task) string {
+	return func(task domain.Task) string {
+		return "due " + formatDate(task.DueAt, now)
+	}
+}
+
+func dueTime(loc *time.Location) func(domain.Task) string {
+	return func(task domain.Task) string {
+		return "due " + task.DueAt.In(loc).Format(agendaTimeLayout)
+	}
+}
+
+func completedTime(loc *time.Location) func(domain.Task) string {
+	return func(task domain.Task) string {
+		completedAt, _ := completionTime(task)
+		return "done " + completedAt.In(loc).Format(agendaTimeLayout)
+	}
+}
+
+// renderAgendaSections paints the tasks with r, whose clock should be the one the sections were built by.
+func renderAgendaSections(sections []agendaSection, r *renderer.Renderer) string {
+	var builder strings.Builder
+
+	for i, section := range sections {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+
+		builder.WriteString(r.Paint(renderer.Header, section.title) + "\n")
+
+		if len(section.tasks) == 0 {
+			builder.WriteString("  none\n")
+		}
+
+		for _, task := range section.tasks {
+			line := fmt.Sprintf("  %-3d %s %s", task.Id,
+				r.Task(task, fmt.Sprintf("%-20s", task.Description)),
+				r.Status(task.CurrentStatus, fmt.Sprintf("%-12s", task.CurrentStatus.String())))
+
+			if section.when != nil {
+				line += " " + section.when(task)
+			}
+
+			builder.WriteString(strings.TrimRight(line, " ") + "\n")
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,253 @@
+package tasks
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+// agendaNow is a Wednesday afternoon.
+var agendaNow = time.Date(2025, 9, 17, 15, 0, 0, 0, time.UTC)
+
+var agendaFixtureTasks = []domain.Task{
+	{Id: 1, Description: "Pay rent", CurrentStatus: domain.Todo, DueAt: time.Date(2025, 9, 15, 18, 0, 0, 0, time.UTC)},
+	{Id: 2, Description: "Write report", CurrentStatus: domain.InProgress, DueAt: time.Date(2025, 9, 17, 17, 0, 0, 0, time.UTC)},
+	{Id: 3, Description: "Call mom", CurrentStatus: domain.Todo, DueAt: time.Date(2025, 9, 17, 9, 0, 0, 0, time.UTC)},
+	{Id: 4, Description: "Refactor", CurrentStatus: domain.InProgress},
+	{Id: 5, Description: "Buy milk", CurrentStatus: domain.Done, CompletedAt: time.Date(2025, 9, 17, 8, 30, 0, 0, time.UTC)},
+	{Id: 6, Description: "Old chore", CurrentStatus: domain.Done, CompletedAt: time.Date(2025, 9, 16, 23, 59, 0, 0, time.UTC)},
+	{Id: 7, Description: "Dentist", CurrentStatus: domain.Todo, DueAt: time.Date(2025, 9, 19, 10, 0, 0, 0, time.UTC)},
+	{Id: 8, Description: "Vacation", CurrentStatus: domain.Todo, DueAt: time.Date(2025, 9, 24, 0, 0, 0, 0, time.UTC)},
+	{Id: 9, Description: "Done early", CurrentStatus: domain.Done, DueAt: time.Date(2025, 9, 18, 12, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 10, 12, 0, 0, 0, time.UTC)},
+}
+
+// sectionIds returns the IDs of the tasks of every section by its title.
+func sectionIds(sections []agendaSection) map[string][]int {
+	ids := make(map[string][]int, len(sections))
+
+	for _, section := range sections {
+		ids[section.title] = []int{}
+
+		for _, task := range section.tasks {
+			ids[section.title] = append(ids[section.title], task.Id)
+		}
+	}
+
+	return ids
+}
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+
+	return loc
+}
+
+func TestBuildToday(t *testing.T) {
+	t.Parallel()
+
+	sections := buildToday(agendaFixtureTasks, agendaNow)
+
+	var titles []string
+	for _, section := range sections {
+		titles = append(titles, section.title)
+	}
+
+	assert.Equal(t, []string{"Overdue", "Due today", "In progress", "Completed today"}, titles)
+	assert.Equal(t, map[string][]int{
+		"Overdue":         {1},
+		"Due today":       {3, 2},
+		"In progress":     {4},
+		"Completed today": {5},
+	}, sectionIds(sections))
+}
+
+func TestBuildAgenda(t *testing.T) {
+	t.Parallel()
+
+	sections := buildAgenda(agendaFixtureTasks, 3, agendaNow)
+
+	var titles []string
+	for _, section := range sections {
+		titles = append(titles, section.title)
+	}
+
+	assert.Equal(t, []string{"Overdue", "Today, Wed 2025-09-17", "Tomorrow, Thu 2025-09-18", "Fri 2025-09-19"}, titles)
+	assert.Equal(t, map[string][]int{
+		"Overdue":                  {1},
+		"Today, Wed 2025-09-17":    {3, 2},
+		"Tomorrow, Thu 2025-09-18": {},
+		"Fri 2025-09-19":           {7},
+	}, sectionIds(sections))
+
+	// Vacation is due on the first day after the agenda.
+	assert.Len(t, sectionIds(buildAgenda(agendaFixtureTasks, 7, agendaNow))["Tue 2025-09-23"], 0)
+	assert.Equal(t, []int{8}, sectionIds(buildAgenda(agendaFixtureTasks, 8, agendaNow))["Wed 2025-09-24"])
+}
+
+func TestBuildAgendaWithoutOverdueTasks(t *testing.T) {
+	t.Parallel()
+
+	sections := buildAgenda(agendaFixtureTasks[1:], 1, agendaNow)
+
+	assert.Len(t, sections, 1)
+	assert.Equal(t, "Today, Wed 2025-09-17", sections[0].title)
+}
+
+func TestAgendaAcrossDaylightSavingTime(t *testing.T) {
+	t.Parallel()
+
+	berlin := loadLocation(t, "Europe/Berlin")
+
+	type testCase struct {
+		name     string
+		now      time.Time
+		dueAt    time.Time
+		days     int
+		expected string
+	}
+
+	tests := []testCase{
+		{
+			// 2025-03-30 has 23 hours, 48 hours after the start of 03-29 is already 03-31 01:00.
+			name:     "Late on the short day",
+			now:      time.Date(2025, 3, 29, 10, 0, 0, 0, berlin),
+			dueAt:    time.Date(2025, 3, 30, 23, 30, 0, 0, berlin),
+			days:     3,
+			expected: "Tomorrow, Sun 2025-03-30",
+		},
+		{
+			name:     "Right after the short day",
+			now:      time.Date(2025, 3, 29, 10, 0, 0, 0, berlin),
+			dueAt:    time.Date(2025, 3, 31, 0, 30, 0, 0, berlin),
+			days:     3,
+			expected: "Mon 2025-03-31",
+		},
+		{
+			// 2025-10-26 has 25 hours, 24 hours after its start it is still 10-26.
+			name:     "Late on the long day",
+			now:      time.Date(2025, 10, 26, 1, 0, 0, 0, berlin),
+			dueAt:    time.Date(2025, 10, 26, 23, 30, 0, 0, berlin),
+			days:     2,
+			expected: "Today, Sun 2025-10-26",
+		},
+		{
+			name:     "Right after the long day",
+			now:      time.Date(2025, 10, 26, 1, 0, 0, 0, berlin),
+			dueAt:    time.Date(2025, 10, 27, 0, 0, 0, 0, berlin),
+			days:     2,
+			expected: "Tomorrow, Mon 2025-10-27",
+		},
+		{
+			name:     "Stored in UTC",
+			now:      time.Date(2025, 10, 26, 1, 0, 0, 0, berlin),
+			dueAt:    time.Date(2025, 10, 26, 23, 30, 0, 0, time.UTC),
+			days:     2,
+			expected: "Tomorrow, Mon 2025-10-27",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := domain.Task{Id: 1, Description: "Flight", DueAt: tt.dueAt}
+			ids := sectionIds(buildAgenda([]domain.Task{task}, tt.days, tt.now))
+
+			assert.Equal(t, []int{1}, ids[tt.expected])
+		})
+	}
+}
+
+func TestTodayAcrossDaylightSavingTime(t *testing.T) {
+	t.Parallel()
+
+	berlin := loadLocation(t, "Europe/Berlin")
+
+	// Late on the 25 hour day, a day ago in hours is still the same day on the calendar.
+	now := time.Date(2025, 10, 26, 23, 30, 0, 0, berlin)
+	tasks := []domain.Task{
+		{Id: 1, Description: "Early", CurrentStatus: domain.Done, CompletedAt: time.Date(2025, 10, 26, 0, 15, 0, 0, berlin)},
+		{Id: 2, Description: "Yesterday", CurrentStatus: domain.Done, CompletedAt: time.Date(2025, 10, 25, 23, 45, 0, 0, berlin)},
+		{Id: 3, Description: "Due at midnight", CurrentStatus: domain.Todo, DueAt: time.Date(2025, 10, 26, 0, 0, 0, 0, berlin)},
+		{Id: 4, Description: "Due tomorrow", CurrentStatus: domain.Todo, DueAt: time.Date(2025, 10, 27, 0, 0, 0, 0, berlin)},
+		{Id: 5, Description: "Due yesterday", CurrentStatus: domain.Todo, DueAt: time.Date(2025, 10, 25, 23, 59, 0, 0, berlin)},
+	}
+
+	assert.Equal(t, map[string][]int{
+		"Overdue":         {5},
+		"Due today":       {3},
+		"In progress":     {},
+		"Completed today": {1},
+	}, sectionIds(buildToday(tasks, now)))
+}
+
+func TestGetToday(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	storage.EXPECT().Load().Return(agendaFixtureTasks, nil).Times(1)
+
+	res, err := getToday(storage, func() time.Time { return agendaNow })
+
+	assert.NoError(t, err)
+	assert.Equal(t, `Overdue
+  1   Pay rent             todo         due 2025-09-15 18:00
+
+Due today
+  3   Call mom             todo         due 09:00
+  2   Write report         in-progress  due 17:00
+
+In progress
+  4   Refactor             in-progress
+
+Completed today
+  5   Buy milk             done         done 08:30
+`, res)
+}
+
+func TestGetAgenda(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	storage.EXPECT().Load().Return(agendaFixtureTasks[1:], nil).Times(1)
+
+	res, err := getAgenda(storage, 2, func() time.Time { return agendaNow })
+
+	assert.NoError(t, err)
+	assert.Equal(t, `Today, Wed 2025-09-17
+  3   Call mom             todo         due 09:00
+  2   Write report         in-progress  due 17:00
+
+Tomorrow, Thu 2025-09-18
+  none
+`, res)
+
+	_, err = getAgenda(storage, 0, func() time.Time { return agendaNow })
+	assert.EqualError(t, err, "invalid number of days 0: expected at least 1")
+}
+
+func TestRenderAgendaSectionsOverdue(t *testing.T) {
+	t.Parallel()
+
+	r := renderer.New(true, renderer.DefaultTheme()).WithClock(func() time.Time { return agendaNow })
+	res := renderAgendaSections(buildToday(agendaFixtureTasks, agendaNow), r)
+
+	assert.Contains(t, res, "\033[1;31mCall mom            \033[0m", "due this morning, overdue by the agenda clock")
+	assert.Contains(t, res, " Write report         ", "due this evening, not overdue yet")
+}
@@ -0,0 +1,143 @@
+package tasks
+
+import (
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ArchiveTasks moves tasks that were completed at least olderThan ago into the archive
+// and returns how many tasks were moved.
+func ArchiveTasks(olderThan time.Duration) (int, error) {
+	return archiveTasks(defaultTaskStorage, defaultEventPublisher(), defaultTaskArchive, olderThan, time.Now)
+}
+
+func GetArchivedTasks() (string, error) {
+	return getArchivedTasksList(defaultTaskArchive)
+}
+
+// ParseAgeString parses ages such as "30d", "2w" or "12h". Besides the day (d) and week (w)
+// units every unit understood by time.ParseDuration is accepted.
+func ParseAgeString(ageStr string) (time.Duration, error) {
+	ageStr = strings.TrimSpace(strings.ToLower(ageStr))
+
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if number, ok := strings.CutSuffix(ageStr, suffix); ok {
+			n, err := strconv.Atoi(number)
+
+			if err != nil || n < 0 {
+				return 0, newInvalidInputError("invalid age string: %s", ageStr)
+			}
+
+			return time.Duration(n) * unit, nil
+		}
+	}
+
+	age, err := time.ParseDuration(ageStr)
+
+	if err != nil || age < 0 {
+		return 0, newInvalidInputError("invalid age string: %s", ageStr)
+	}
+
+	return age, nil
+}
+
+func archiveTasks(taskStorage domain.TaskStorage, publisher domain.EventPublisher, archive domain.TaskArchive,
+	olderThan time.Duration, now func() time.Time) (int, error) {
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return 0, err
+	}
+
+	remaining, archivable := splitArchivable(tasks, now().Add(-olderThan))
+
+	if len(archivable) == 0 {
+		return 0, nil
+	}
+
+	events := make([]domain.Event, 0, len(archivable))
+
+	for _, task := range archivable {
+		event := domain.Event{Type: domain.TaskArchived, Task: task, OccurredAt: now()}
+
+		if err := publisher.Before(event); err != nil {
+			return 0, err
+		}
+
+		events = append(events, event)
+	}
+
+	if err := appendToArchive(archive, archivable); err != nil {
+		return 0, err
+	}
+
+	if err := taskStorage.Save(remaining); err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		publisher.Publish(event)
+	}
+
+	return len(archivable), nil
+}
+
+func getArchivedTasksList(archive domain.TaskArchive) (string, error) {
+	tasks, err := archive.Load()
+
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	builder.WriteString(getTaskListHeader())
+
+	for _, task := range tasks {
+		builder.WriteString(getTaskShortDescription(task))
+	}
+
+	return builder.String(), nil
+}
+
+// moveToArchive appends archivable tasks to the archive and returns the tasks that stay active.
+// The archive is written first so a failure never loses tasks.
+func moveToArchive(archive domain.TaskArchive, tasks []domain.Task, olderThan time.Duration, now func() time.Time) ([]domain.Task, int, error) {
+	remaining, archivable := splitArchivable(tasks, now().Add(-olderThan))
+
+	if len(archivable) == 0 {
+		return tasks, 0, nil
+	}
+
+	if err := appendToArchive(archive, archivable); err != nil {
+		return nil, 0, err
+	}
+
+	return remaining, len(archivable), nil
+}
+
+func appendToArchive(archive domain.TaskArchive, tasks []domain.Task) error {
+	archived, err := archive.Load()
+
+	if err != nil {
+		return err
+	}
+
+	return archive.Save(append(archived, tasks...))
+}
+
+// splitArchivable separates done tasks completed no later than cutoff from the rest.
+func splitArchivable(tasks []domain.Task, cutoff time.Time) (remaining []domain.Task, archivable []domain.Task) {
+	remaining = make([]domain.Task, 0, len(tasks))
+
+	for _, task := range tasks {
+		if completedAt, ok := completionTime(task); ok && !completedAt.After(cutoff) {
+			archivable = append(archivable, task)
+		} else {
+			remaining = append(remaining, task)
+		}
+	}
+
+	return remaining, archivable
+}
@@ -0,0 +1,217 @@
+package tasks
+
+import (
+	"fmt"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseAgeString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		input       string
+		expected    time.Duration
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{name: "Days", input: "30d", expected: 30 * 24 * time.Hour},
+		{name: "Weeks", input: "2W", expected: 14 * 24 * time.Hour},
+		{name: "Hours", input: "12h", expected: 12 * time.Hour},
+		{name: "Zero", input: "0d", expected: 0},
+		{name: "Invalid number", input: "xd", expectedErr: fmt.Errorf("invalid age string: %s", "xd")},
+		{name: "Negative", input: "-1h", expectedErr: fmt.Errorf("invalid age string: %s", "-1h")},
+		{name: "Empty string", input: "", expectedErr: fmt.Errorf("invalid age string: %s", "")},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseAgeString(tt.input)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestArchiveTasks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 9, 30, 12, 0, 0, 0, time.UTC)
+
+	oldDone := domain.Task{Id: 1, Description: "Old done", CurrentStatus: domain.Done, CompletedAt: time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)}
+	legacyDone := domain.Task{Id: 2, Description: "Legacy done", CurrentStatus: domain.Done, UpdatedAt: time.Date(2025, 8, 30, 12, 0, 0, 0, time.UTC)}
+	recentDone := domain.Task{Id: 3, Description: "Recent done", CurrentStatus: domain.Done, CompletedAt: time.Date(2025, 9, 29, 12, 0, 0, 0, time.UTC)}
+	oldTodo := domain.Task{Id: 4, Description: "Old todo", CurrentStatus: domain.Todo, CreatedAt: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+	alreadyArchived := domain.Task{Id: 5, Description: "Archived", CurrentStatus: domain.Done}
+
+	type testCase struct {
+		name          string
+		testStorageFn func(t *testing.T) (domain.TaskStorage, domain.EventPublisher, domain.TaskArchive)
+		expected      int
+		expectedErr   error
+	}
+
+	tests := []testCase{
+		{
+			name: "Successful Archive",
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher, domain.TaskArchive) {
+				t.Helper()
+
+				storage := mocks.NewMockTaskStorage(ctrl)
+				archive := mocks.NewMockTaskArchive(ctrl)
+
+				publisher := mocks.NewMockEventPublisher(ctrl)
+
+				oldDoneEvent := domain.Event{Type: domain.TaskArchived, Task: oldDone, OccurredAt: now}
+				legacyDoneEvent := domain.Event{Type: domain.TaskArchived, Task: legacyDone, OccurredAt: now}
+
+				firstCall := storage.EXPECT().Load().Return([]domain.Task{oldDone, legacyDone, recentDone, oldTodo}, nil).Times(1)
+				publisher.EXPECT().Before(oldDoneEvent).Return(nil).Times(1)
+				publisher.EXPECT().Before(legacyDoneEvent).Return(nil).Times(1)
+				secondCall := archive.EXPECT().Load().Return([]domain.Task{alreadyArchived}, nil).Times(1).After(firstCall)
+				thirdCall := archive.EXPECT().Save(gomock.Eq([]domain.Task{alreadyArchived, oldDone, legacyDone})).Times(1).After(secondCall)
+				fourthCall := storage.EXPECT().Save(gomock.Eq([]domain.Task{recentDone, oldTodo})).Times(1).After(thirdCall)
+				publisher.EXPECT().Publish(oldDoneEvent).Times(1).After(fourthCall)
+				publisher.EXPECT().Publish(legacyDoneEvent).Times(1).After(fourthCall)
+
+				return storage, publisher, archive
+			},
+			expected: 2,
+		},
+		{
+			name: "Nothing to archive",
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher, domain.TaskArchive) {
+				t.Helper()
+
+				storage := mocks.NewMockTaskStorage(ctrl)
+				storage.EXPECT().Load().Return([]domain.Task{recentDone, oldTodo}, nil).Times(1)
+
+				return storage, mocks.NewMockEventPublisher(ctrl), mocks.NewMockTaskArchive(ctrl)
+			},
+			expected: 0,
+		},
+		{
+			name: "Storage Load Error",
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher, domain.TaskArchive) {
+				t.Helper()
+
+				storage := mocks.NewMockTaskStorage(ctrl)
+				storage.EXPECT().Load().Return(nil, assert.AnError).Times(1)
+
+				return storage, mocks.NewMockEventPublisher(ctrl), mocks.NewMockTaskArchive(ctrl)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name: "Archive Save Error keeps tasks",
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher, domain.TaskArchive) {
+				t.Helper()
+
+				storage := mocks.NewMockTaskStorage(ctrl)
+				archive := mocks.NewMockTaskArchive(ctrl)
+
+				publisher := mocks.NewMockEventPublisher(ctrl)
+
+				firstCall := storage.EXPECT().Load().Return([]domain.Task{oldDone}, nil).Times(1)
+				publisher.EXPECT().Before(gomock.Any()).Return(nil).Times(1)
+				secondCall := archive.EXPECT().Load().Return([]domain.Task{}, nil).Times(1).After(firstCall)
+				archive.EXPECT().Save(gomock.Any()).Return(assert.AnError).Times(1).After(secondCall)
+
+				return storage, publisher, archive
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name: "Storage Save Error",
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher, domain.TaskArchive) {
+				t.Helper()
+
+				storage := mocks.NewMockTaskStorage(ctrl)
+				archive := mocks.NewMockTaskArchive(ctrl)
+
+				publisher := mocks.NewMockEventPublisher(ctrl)
+
+				firstCall := storage.EXPECT().Load().Return([]domain.Task{oldDone}, nil).Times(1)
+				publisher.EXPECT().Before(gomock.Any()).Return(nil).Times(1)
+				secondCall := archive.EXPECT().Load().Return([]domain.Task{}, nil).Times(1).After(firstCall)
+				thirdCall := archive.EXPECT().Save(gomock.Any()).Times(1).After(secondCall)
+				storage.EXPECT().Save(gomock.Any()).Return(assert.AnError).Times(1).After(thirdCall)
+
+				return storage, publisher, archive
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name: "Rejected by a hook",
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher, domain.TaskArchive) {
+				t.Helper()
+
+				storage := mocks.NewMockTaskStorage(ctrl)
+				publisher := mocks.NewMockEventPublisher(ctrl)
+
+				storage.EXPECT().Load().Return([]domain.Task{oldDone, legacyDone}, nil).Times(1)
+				publisher.EXPECT().Before(domain.Event{Type: domain.TaskArchived, Task: oldDone, OccurredAt: now}).Return(assert.AnError).Times(1)
+
+				return storage, publisher, mocks.NewMockTaskArchive(ctrl)
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage, publisher, archive := tt.testStorageFn(t)
+			count, err := archiveTasks(storage, publisher, archive, 30*24*time.Hour, func() time.Time {
+				return now
+			})
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, count)
+			}
+		})
+	}
+}
+
+func TestGetArchivedTasksList(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	task := domain.Task{Id: 1, Description: "Task 1", CurrentStatus: domain.Done, CreatedAt: time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 2, 9, 0, 0, 0, time.UTC)}
+
+	archive := mocks.NewMockTaskArchive(ctrl)
+	archive.EXPECT().Load().Return([]domain.Task{task}, nil).Times(1)
+
+	out, err := getArchivedTasksList(archive)
+	assert.NoError(t, err)
+	assert.Equal(t, getTaskListHeader()+getTaskShortDescription(task), out)
+
+	archive.EXPECT().Load().Return(nil, assert.AnError).Times(1)
+
+	_, err = getArchivedTasksList(archive)
+	assert.EqualError(t, err, assert.AnError.Error())
+}
@@ -0,0 +1,105 @@
+package tasks
+
+import (
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"sort"
+	"strings"
+	"time"
+)
+
+// currentUser creates and is first assigned the new tasks, see SetCurrentUser.
+var currentUser string
+
+// SetCurrentUser sets the name recorded as creator and assignee of new tasks.
+func SetCurrentUser(name string) {
+	currentUser = strings.TrimSpace(name)
+}
+
+// CurrentUser returns the name set with SetCurrentUser.
+func CurrentUser() string {
+	return currentUser
+}
+
+// AssignTask makes assignee responsible for the task, an empty assignee unassigns it.
+func AssignTask(id int, assignee string) error {
+	return assignTask(defaultTaskStorage, defaultEventPublisher(), id, assignee, time.Now)
+}
+
+// GetAllAssignedTasks lists the tasks assigned to assignee.
+func GetAllAssignedTasks(assignee string) (string, error) {
+	return getMatchingTasksList(defaultTaskStorage, func(task domain.Task) bool {
+		return isAssignedTo(task, assignee)
+	})
+}
+
+// GetAssignedTasks lists the tasks assigned to assignee with the given status.
+func GetAssignedTasks(assignee string, status domain.Status) (string, error) {
+	return getMatchingTasksList(defaultTaskStorage, func(task domain.Task) bool {
+		return task.CurrentStatus == status && isAssignedTo(task, assignee)
+	})
+}
+
+// ListUsers returns the assignees and creators of the tasks in alphabetical order.
+func ListUsers() ([]string, error) {
+	tasks, err := defaultTaskStorage.Load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return taskUsers(tasks), nil
+}
+
+func assignTask(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id int, assignee string, now func() time.Time) error {
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return err
+	}
+
+	for i := range tasks {
+		if tasks[i].Id == id {
+			tasks[i].Assignee = strings.TrimSpace(assignee)
+			tasks[i].UpdatedAt = now()
+			event := domain.Event{Type: domain.TaskUpdated, Task: tasks[i], OccurredAt: now()}
+
+			if err := publisher.Before(event); err != nil {
+				return err
+			}
+
+			if err := taskStorage.Save(tasks); err != nil {
+				return err
+			}
+
+			publisher.Publish(event)
+			return nil
+		}
+	}
+
+	return newTaskNotFoundError(id)
+}
+
+// isAssignedTo compares user names case-insensitively, "Alice" and "alice" are the same person.
+func isAssignedTo(task domain.Task, assignee string) bool {
+	return task.Assignee != "" && strings.EqualFold(task.Assignee, strings.TrimSpace(assignee))
+}
+
+func taskUsers(tasks []domain.Task) []string {
+	seen := make(map[string]bool)
+	var users []string
+
+	for _, task := range tasks {
+		for _, name := range []string{task.Assignee, task.CreatedBy} {
+			if name != "" && !seen[strings.ToLower(name)] {
+				seen[strings.ToLower(name)] = true
+				users = append(users, name)
+			}
+		}
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		return strings.ToLower(users[i]) < strings.ToLower(users[j])
+	})
+
+	return users
+}
@@ -0,0 +1,110 @@
+package tasks
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestAssignTask(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 9, 10, 10, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name        string
+		id          int
+		assignee    string
+		expected    domain.Task
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:     "Assign",
+			id:       1,
+			assignee: " bob ",
+			expected: domain.Task{Id: 1, Description: "Write report", CreatedBy: "alice", Assignee: "bob", UpdatedAt: now},
+		},
+		{
+			name:     "Unassign",
+			id:       1,
+			assignee: "",
+			expected: domain.Task{Id: 1, Description: "Write report", CreatedBy: "alice", UpdatedAt: now},
+		},
+		{
+			name:        "Missing task",
+			id:          2,
+			assignee:    "bob",
+			expectedErr: ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mocks.NewMockTaskStorage(ctrl)
+			publisher := mocks.NewMockEventPublisher(ctrl)
+
+			storage.EXPECT().Load().Return([]domain.Task{{Id: 1, Description: "Write report", CreatedBy: "alice", Assignee: "alice"}}, nil).Times(1)
+
+			if tt.expectedErr == nil {
+				event := domain.Event{Type: domain.TaskUpdated, Task: tt.expected, OccurredAt: now}
+				publisher.EXPECT().Before(event).Return(nil).Times(1)
+				storage.EXPECT().Save([]domain.Task{tt.expected}).Return(nil).Times(1)
+				publisher.EXPECT().Publish(event).Times(1)
+			}
+
+			err := assignTask(storage, publisher, tt.id, tt.assignee, func() time.Time { return now })
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetMatchingTasksListByAssignee(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	created := time.Date(2025, 9, 10, 10, 0, 0, 0, time.UTC)
+	tasks := []domain.Task{
+		{Id: 1, Description: "Write report", CreatedAt: created, UpdatedAt: created, Assignee: "Alice"},
+		{Id: 2, Description: "Review PR", CreatedAt: created, UpdatedAt: created, Assignee: "bob"},
+		{Id: 3, Description: "Buy milk", CreatedAt: created, UpdatedAt: created},
+	}
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	storage.EXPECT().Load().Return(tasks, nil).Times(1)
+
+	res, err := getMatchingTasksList(storage, func(task domain.Task) bool { return isAssignedTo(task, "alice") })
+
+	assert.NoError(t, err)
+	assert.Equal(t, getTaskListHeader()+getTaskShortDescription(tasks[0]), res)
+}
+
+func TestTaskUsers(t *testing.T) {
+	t.Parallel()
+
+	tasks := []domain.Task{
+		{Id: 1, CreatedBy: "carol", Assignee: "Bob"},
+		{Id: 2, CreatedBy: "alice", Assignee: "bob"},
+		{Id: 3},
+	}
+
+	assert.Equal(t, []string{"alice", "Bob", "carol"}, taskUsers(tasks))
+	assert.Empty(t, taskUsers(nil))
+}
@@ -0,0 +1,195 @@
+package tasks
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	boardColumnGap      = 2
+	boardMinColumnWidth = 16
+)
+
+// BoardOptions select what the kanban board shows.
+type BoardOptions struct {
+	// Statuses are the board columns from left to right, all statuses when empty.
+	Statuses []domain.Status
+	Tag      string
+	Project  string
+	// WipLimits warns about columns holding more tasks than their limit.
+	WipLimits map[domain.Status]int
+}
+
+// GetBoard renders the tasks as a kanban board that fits into width characters.
+func GetBoard(options BoardOptions, width int) (string, error) {
+	return getBoard(defaultTaskStorage, options, width)
+}
+
+func getBoard(storage domain.TaskStorage, options BoardOptions, width int) (string, error) {
+	tasks, err := storage.Load()
+
+	if err != nil {
+		return "", err
+	}
+
+	return renderBoard(tasks, options, width), nil
+}
+
+func renderBoard(tasks []domain.Task, options BoardOptions, width int) string {
+	statuses := options.Statuses
+	if len(statuses) == 0 {
+		statuses = []domain.Status{domain.Todo, domain.InProgress, domain.Done}
+	}
+
+	columnWidth := max((width-boardColumnGap*(len(statuses)-1))/len(statuses), boardMinColumnWidth)
+	columns := make([][]string, len(statuses))
+	var warnings []string
+
+	for i, status := range statuses {
+		var cards []string
+		count := 0
+
+		for _, task := range tasks {
+			if task.CurrentStatus != status || !matchesBoardFilter(task, options) {
+				continue
+			}
+
+			count++
+			cards = append(cards, renderCard(task, columnWidth)...)
+		}
+
+		header := fmt.Sprintf("%s (%d)", strings.ToUpper(status.String()), count)
+
+		if limit, ok := options.WipLimits[status]; ok {
+			header = fmt.Sprintf("%s (%d/%d)", strings.ToUpper(status.String()), count, limit)
+
+			if count > limit {
+				header += " !"
+				warnings = append(warnings, fmt.Sprintf("Warning: %s has %d tasks, WIP limit is %d.", status.String(), count, limit))
+			}
+		}
+
+		columns[i] = append([]string{fitWidth(header, columnWidth), strings.Repeat("═", columnWidth)}, cards...)
+	}
+
+	var builder strings.Builder
+	height := 0
+	for _, column := range columns {
+		height = max(height, len(column))
+	}
+
+	for row := 0; row < height; row++ {
+		cells := make([]string, len(columns))
+
+		for i, column := range columns {
+			cell := ""
+			if row < len(column) {
+				cell = column[row]
+			}
+
+			cells[i] = padToWidth(cell, columnWidth)
+
+			// The padding stays outside of the colors so that it can be trimmed from the last column.
+			if row == 0 {
+				cells[i] = taskRenderer.Status(statuses[i], cell) + strings.TrimPrefix(cells[i], cell)
+			}
+		}
+
+		builder.WriteString(strings.TrimRight(strings.Join(cells, strings.Repeat(" ", boardColumnGap)), " "))
+		builder.WriteString("\n")
+	}
+
+	for _, warning := range warnings {
+		builder.WriteString(taskRenderer.Paint(renderer.Warning, warning) + "\n")
+	}
+
+	return builder.String()
+}
+
+func matchesBoardFilter(task domain.Task, options BoardOptions) bool {
+	if options.Tag != "" && !task.HasTag(options.Tag) {
+		return false
+	}
+
+	if options.Project != "" && !task.HasProject(options.Project) {
+		return false
+	}
+
+	return true
+}
+
+// renderCard draws a task as a box of exactly width characters.
+func renderCard(task domain.Task, width int) []string {
+	inner := width - 4
+	lines := []string{"┌" + strings.Repeat("─", width-2) + "┐"}
+
+	content := wrapText(fmt.Sprintf("#%d %s", task.Id, task.Description), inner)
+
+	var meta []string
+	if task.Priority != domain.NoPriority {
+		meta = append(meta, task.Priority.String())
+	}
+	if !task.DueAt.IsZero() {
+		meta = append(meta, "due "+task.DueAt.In(dates.location).Format(importDateLayout))
+	}
+	if len(meta) > 0 {
+		content = append(content, wrapText(strings.Join(meta, ", "), inner)...)
+	}
+
+	for _, line := range content {
+		lines = append(lines, "│ "+taskRenderer.Task(task, padToWidth(line, inner))+" │")
+	}
+
+	return append(lines, "└"+strings.Repeat("─", width-2)+"┘")
+}
+
+// wrapText breaks text into lines of at most width characters, splitting words that do not fit.
+func wrapText(text string, width int) []string {
+	var lines []string
+	line := ""
+
+	for _, word := range strings.Fields(text) {
+		for utf8.RuneCountInString(word) > width {
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+
+			runes := []rune(word)
+			lines = append(lines, string(runes[:width]))
+			word = string(runes[width:])
+		}
+
+		if line != "" && utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+
+		if line != "" {
+			line += " "
+		}
+
+		line += word
+	}
+
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func fitWidth(text string, width int) string {
+	if utf8.RuneCountInString(text) <= width {
+		return text
+	}
+
+	return string([]rune(text)[:width])
+}
+
+func padToWidth(text string, width int) string {
+	return text + strings.Repeat(" ", max(width-utf8.RuneCountInString(text), 0))
+}
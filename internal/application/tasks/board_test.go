@@ -0,0 +1,129 @@
+package tasks
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+var boardFixtureTasks = []domain.Task{
+	{Id: 1, Description: "Fix login #bug +website", CurrentStatus: domain.Todo},
+	{Id: 2, Description: "Write docs +website", CurrentStatus: domain.InProgress, Priority: domain.HighPriority, DueAt: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)},
+	{Id: 3, Description: "Deploy", CurrentStatus: domain.InProgress},
+	{Id: 4, Description: "Plan #Bug bash", CurrentStatus: domain.Done},
+}
+
+func TestRenderBoard(t *testing.T) {
+	t.Parallel()
+
+	out := renderBoard(boardFixtureTasks, BoardOptions{}, 56)
+
+	assert.Equal(t, ""+
+		"TODO (1)           IN-PROGRESS (2)    DONE (1)\n"+
+		"═════════════════  ═════════════════  ═════════════════\n"+
+		"┌───────────────┐  ┌───────────────┐  ┌───────────────┐\n"+
+		"│ #1 Fix login  │  │ #2 Write docs │  │ #4 Plan #Bug  │\n"+
+		"│ #bug +website │  │ +website      │  │ bash          │\n"+
+		"└───────────────┘  │ high, due     │  └───────────────┘\n"+
+		"                   │ 2025-10-01    │\n"+
+		"                   └───────────────┘\n"+
+		"                   ┌───────────────┐\n"+
+		"                   │ #3 Deploy     │\n"+
+		"                   └───────────────┘\n", out)
+}
+
+func TestRenderBoardOptions(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		options  BoardOptions
+		contains []string
+		excludes []string
+	}
+
+	tests := []testCase{
+		{
+			name:     "Tag filter is case insensitive",
+			options:  BoardOptions{Tag: "#BUG"},
+			contains: []string{"TODO (1)", "IN-PROGRESS (0)", "DONE (1)", "#1 Fix", "#4 Plan"},
+			excludes: []string{"#2 Write", "#3 Deploy"},
+		},
+		{
+			name:     "Project filter",
+			options:  BoardOptions{Project: "website"},
+			contains: []string{"TODO (1)", "IN-PROGRESS (1)", "DONE (0)"},
+			excludes: []string{"#3 Deploy", "#4 Plan"},
+		},
+		{
+			name:     "Configured columns",
+			options:  BoardOptions{Statuses: []domain.Status{domain.InProgress, domain.Todo}},
+			contains: []string{"IN-PROGRESS (2)                          TODO (1)\n"},
+			excludes: []string{"DONE"},
+		},
+		{
+			name:     "WIP limit exceeded",
+			options:  BoardOptions{WipLimits: map[domain.Status]int{domain.InProgress: 1, domain.Todo: 1}},
+			contains: []string{"TODO (1/1)", "IN-PROGRESS (2/1) !", "Warning: in-progress has 2 tasks, WIP limit is 1.\n"},
+			excludes: []string{"Warning: todo"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			out := renderBoard(boardFixtureTasks, tt.options, 80)
+
+			for _, expected := range tt.contains {
+				assert.Contains(t, out, expected)
+			}
+
+			for _, unexpected := range tt.excludes {
+				assert.NotContains(t, out, unexpected)
+			}
+		})
+	}
+}
+
+func TestRenderBoardNarrowTerminal(t *testing.T) {
+	t.Parallel()
+
+	out := renderBoard(boardFixtureTasks, BoardOptions{}, 20)
+
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+		assert.LessOrEqual(t, len([]rune(line)), 3*boardMinColumnWidth+2*boardColumnGap)
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"a b", "cde"}, wrapText("a b cde", 3))
+	assert.Equal(t, []string{"abc", "def", "g h"}, wrapText("abcdefg h", 3))
+	assert.Equal(t, []string{""}, wrapText("", 3))
+}
+
+func TestGetBoard(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	storage.EXPECT().Load().Return(boardFixtureTasks, nil).Times(1)
+
+	out, err := getBoard(storage, BoardOptions{}, 56)
+	assert.NoError(t, err)
+	assert.Equal(t, renderBoard(boardFixtureTasks, BoardOptions{}, 56), out)
+
+	storage.EXPECT().Load().Return(nil, assert.AnError).Times(1)
+
+	_, err = getBoard(storage, BoardOptions{}, 56)
+	assert.EqualError(t, err, assert.AnError.Error())
+}
@@ -0,0 +1,147 @@
+package tasks
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"strings"
+	"time"
+)
+
+var errEmptyComment error = &invalidInputError{message: "the comment is empty"}
+
+// AddComment adds a comment by the current user to the task.
+func AddComment(id int, body string) (domain.Comment, error) {
+	return addComment(defaultTaskStorage, defaultEventPublisher(), id, currentUser, body, time.Now)
+}
+
+// EditComment replaces the text of a comment the current user wrote.
+func EditComment(id, commentId int, body string) error {
+	return editComment(defaultTaskStorage, defaultEventPublisher(), id, commentId, currentUser, body, time.Now)
+}
+
+// DeleteComment removes a comment the current user wrote.
+func DeleteComment(id, commentId int) error {
+	return deleteComment(defaultTaskStorage, defaultEventPublisher(), id, commentId, currentUser, time.Now)
+}
+
+func addComment(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id int, author, body string, now func() time.Time) (domain.Comment, error) {
+	body = strings.TrimSpace(body)
+
+	if body == "" {
+		return domain.Comment{}, errEmptyComment
+	}
+
+	var comment domain.Comment
+
+	err := changeComments(taskStorage, publisher, id, now, func(task *domain.Task) error {
+		comment = domain.Comment{Id: nextCommentId(task.Comments), Author: author, Body: body, CreatedAt: now()}
+		task.Comments = append(task.Comments, comment)
+		return nil
+	})
+
+	return comment, err
+}
+
+func editComment(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id, commentId int, user, body string, now func() time.Time) error {
+	body = strings.TrimSpace(body)
+
+	if body == "" {
+		return errEmptyComment
+	}
+
+	return changeComments(taskStorage, publisher, id, now, func(task *domain.Task) error {
+		i, err := ownComment(*task, commentId, user)
+
+		if err != nil {
+			return err
+		}
+
+		task.Comments[i].Body = body
+		task.Comments[i].UpdatedAt = now()
+		return nil
+	})
+}
+
+func deleteComment(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id, commentId int, user string, now func() time.Time) error {
+	return changeComments(taskStorage, publisher, id, now, func(task *domain.Task) error {
+		i, err := ownComment(*task, commentId, user)
+
+		if err != nil {
+			return err
+		}
+
+		task.Comments = append(task.Comments[:i:i], task.Comments[i+1:]...)
+		return nil
+	})
+}
+
+// changeComments applies change to the task and saves it, a failed change leaves the task untouched.
+func changeComments(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id int, now func() time.Time, change func(task *domain.Task) error) error {
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return err
+	}
+
+	for i := range tasks {
+		if tasks[i].Id == id {
+			if err := change(&tasks[i]); err != nil {
+				return err
+			}
+
+			tasks[i].UpdatedAt = now()
+			event := domain.Event{Type: domain.TaskUpdated, Task: tasks[i], OccurredAt: now()}
+
+			if err := publisher.Before(event); err != nil {
+				return err
+			}
+
+			if err := taskStorage.Save(tasks); err != nil {
+				return err
+			}
+
+			publisher.Publish(event)
+			return nil
+		}
+	}
+
+	return newTaskNotFoundError(id)
+}
+
+// ownComment returns the index of the comment commentId of task, provided user wrote it.
+func ownComment(task domain.Task, commentId int, user string) (int, error) {
+	for i, comment := range task.Comments {
+		if comment.Id != commentId {
+			continue
+		}
+
+		if !strings.EqualFold(comment.Author, user) {
+			return 0, fmt.Errorf("comment %d on task %d was written by %s, only your own comments can be changed",
+				commentId, task.Id, authorName(comment.Author))
+		}
+
+		return i, nil
+	}
+
+	return 0, &commentNotFoundError{taskId: task.Id, commentId: commentId}
+}
+
+// nextCommentId continues after the highest comment ID, so that the ID of a deleted comment is not reused
+// unless it was the last one.
+func nextCommentId(comments []domain.Comment) int {
+	maxId := 0
+
+	for _, comment := range comments {
+		maxId = max(maxId, comment.Id)
+	}
+
+	return maxId + 1
+}
+
+func authorName(author string) string {
+	if author == "" {
+		return "an unknown user"
+	}
+
+	return author
+}
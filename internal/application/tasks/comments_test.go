@@ -0,0 +1,145 @@
+package tasks
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestComments(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 9, 10, 10, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	clock := func() time.Time { return now }
+
+	first := domain.Comment{Id: 1, Author: "alice", Body: "Draft is in the wiki", CreatedAt: earlier}
+	second := domain.Comment{Id: 2, Author: "bob", Body: "Looks good", CreatedAt: earlier}
+	task := domain.Task{Id: 1, Description: "Write report", Comments: []domain.Comment{first, second}}
+
+	withComments := func(comments ...domain.Comment) domain.Task {
+		changed := task
+		changed.Comments = comments
+		changed.UpdatedAt = now
+		return changed
+	}
+
+	type testCase struct {
+		name        string
+		run         func(storage domain.TaskStorage, publisher domain.EventPublisher) error
+		expected    *domain.Task
+		expectedErr string
+		expectedIs  error
+	}
+
+	tests := []testCase{
+		{
+			name: "Add",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				comment, err := addComment(storage, publisher, 1, "alice", "  Sent to review\n", clock)
+				assert.Equal(t, domain.Comment{Id: 3, Author: "alice", Body: "Sent to review", CreatedAt: now}, comment)
+				return err
+			},
+			expected: ptr(withComments(first, second, domain.Comment{Id: 3, Author: "alice", Body: "Sent to review", CreatedAt: now})),
+		},
+		{
+			name: "Add an empty comment",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				_, err := addComment(storage, publisher, 1, "alice", " ", clock)
+				return err
+			},
+			expectedErr: "the comment is empty",
+		},
+		{
+			name: "Edit an own comment",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				return editComment(storage, publisher, 1, 1, "Alice", "Draft is in the shared folder", clock)
+			},
+			expected: ptr(withComments(domain.Comment{Id: 1, Author: "alice", Body: "Draft is in the shared folder", CreatedAt: earlier, UpdatedAt: now}, second)),
+		},
+		{
+			name: "Edit the comment of someone else",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				return editComment(storage, publisher, 1, 2, "alice", "Looks bad", clock)
+			},
+			expectedErr: "comment 2 on task 1 was written by bob, only your own comments can be changed",
+		},
+		{
+			name: "Delete an own comment",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				return deleteComment(storage, publisher, 1, 2, "bob", clock)
+			},
+			expected: ptr(withComments(first)),
+		},
+		{
+			name: "Delete a missing comment",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				return deleteComment(storage, publisher, 1, 7, "bob", clock)
+			},
+			expectedErr: "comment 7 not found on task 1",
+			expectedIs:  ErrCommentNotFound,
+		},
+		{
+			name: "Comment on a missing task",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				_, err := addComment(storage, publisher, 9, "alice", "Hello", clock)
+				return err
+			},
+			expectedErr: "task with id [9] not found",
+			expectedIs:  ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mocks.NewMockTaskStorage(ctrl)
+			publisher := mocks.NewMockEventPublisher(ctrl)
+
+			if tt.expectedErr != "the comment is empty" {
+				// The stored comments must not be changed by the operations.
+				stored := task
+				stored.Comments = append([]domain.Comment(nil), task.Comments...)
+				storage.EXPECT().Load().Return([]domain.Task{stored}, nil).Times(1)
+			}
+
+			if tt.expected != nil {
+				event := domain.Event{Type: domain.TaskUpdated, Task: *tt.expected, OccurredAt: now}
+				publisher.EXPECT().Before(event).Return(nil).Times(1)
+				storage.EXPECT().Save([]domain.Task{*tt.expected}).Return(nil).Times(1)
+				publisher.EXPECT().Publish(event).Times(1)
+			}
+
+			err := tt.run(storage, publisher)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tt.expectedIs != nil {
+				assert.ErrorIs(t, err, tt.expectedIs)
+			}
+		})
+	}
+}
+
+func TestNextCommentId(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 1, nextCommentId(nil))
+	assert.Equal(t, 4, nextCommentId([]domain.Comment{{Id: 3}, {Id: 1}}))
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
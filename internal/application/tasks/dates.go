@@ -0,0 +1,146 @@
+package tasks
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"time"
+)
+
+const defaultDateLayout = "2006-01-02 15:04"
+
+// relativeDatesLimit is the age from which relative dates are shown with the date layout again,
+// "5w ago" is harder to place than a date.
+const relativeDatesLimit = 28 * 24 * time.Hour
+
+// dateFormat describes how dates are shown in the task output.
+type dateFormat struct {
+	layout   string
+	location *time.Location
+	// relative shows recent dates as "3h ago" instead of with the layout.
+	relative bool
+}
+
+var dates = dateFormat{layout: defaultDateLayout, location: time.Local}
+
+// SetDateLayout changes the layout of the dates in the task output. An empty layout restores the default.
+func SetDateLayout(layout string) {
+	if layout == "" {
+		layout = defaultDateLayout
+	}
+
+	dates.layout = layout
+}
+
+// SetTimeZone changes the zone the dates are shown in and the days of the reports are counted in.
+// A nil location uses the local zone.
+func SetTimeZone(loc *time.Location) {
+	if loc == nil {
+		loc = time.Local
+	}
+
+	dates.location = loc
+}
+
+// SetRelativeDates shows recent dates relative to now, e.g. "3h ago", instead of with the date layout.
+func SetRelativeDates(relative bool) {
+	dates.relative = relative
+}
+
+// FormatDate renders t the way the task tables show dates.
+func FormatDate(t time.Time) string {
+	return formatDate(t, time.Now())
+}
+
+// Now returns the current time in the display zone. It is the clock of the reports and of relative
+// input such as "tomorrow 9am", so that days start at midnight of that zone.
+func Now() time.Time {
+	return time.Now().In(dates.location)
+}
+
+func formatDate(t, now time.Time) string {
+	return dates.format(t, now)
+}
+
+func (f dateFormat) format(t, now time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	if f.relative {
+		if d := now.Sub(t); d < relativeDatesLimit && d > -relativeDatesLimit {
+			return formatRelative(d)
+		}
+	}
+
+	return t.In(f.location).Format(f.layout)
+}
+
+// formatRelative renders how long ago something happened, a negative d lies in the future.
+func formatRelative(d time.Duration) string {
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	var amount string
+
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		amount = fmt.Sprintf("%dm", int(d/time.Minute))
+	case d < 24*time.Hour:
+		amount = fmt.Sprintf("%dh", int(d/time.Hour))
+	default:
+		amount = fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	}
+
+	if future {
+		return "in " + amount
+	}
+
+	return amount + " ago"
+}
+
+// inUTC returns a copy of tasks with every timestamp in UTC, the zone the tasks are stored in.
+// Times are shown in the display zone, so a task file moved between machines reads the same everywhere.
+func inUTC(tasks []domain.Task) []domain.Task {
+	normalized := make([]domain.Task, len(tasks))
+
+	for i, task := range tasks {
+		for _, t := range []*time.Time{&task.CreatedAt, &task.UpdatedAt, &task.CompletedAt, &task.DueAt, &task.RemindAt} {
+			if !t.IsZero() {
+				*t = t.UTC()
+			}
+		}
+
+		if task.TimeEntries != nil {
+			entries := make([]domain.TimeEntry, len(task.TimeEntries))
+
+			for j, entry := range task.TimeEntries {
+				entries[j] = domain.TimeEntry{StartedAt: entry.StartedAt.UTC(), EndedAt: entry.EndedAt.UTC()}
+			}
+
+			task.TimeEntries = entries
+		}
+
+		if task.Comments != nil {
+			comments := make([]domain.Comment, len(task.Comments))
+
+			for j, comment := range task.Comments {
+				comment.CreatedAt = comment.CreatedAt.UTC()
+				if !comment.UpdatedAt.IsZero() {
+					comment.UpdatedAt = comment.UpdatedAt.UTC()
+				}
+
+				comments[j] = comment
+			}
+
+			task.Comments = comments
+		}
+
+		normalized[i] = task
+	}
+
+	return normalized
+}
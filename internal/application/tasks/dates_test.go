@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDateFormat(t *testing.T) {
+	t.Parallel()
+
+	berlin := loadLocation(t, "Europe/Berlin")
+	now := time.Date(2025, 9, 17, 12, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name     string
+		format   dateFormat
+		date     time.Time
+		expected string
+	}
+
+	tests := []testCase{
+		{name: "Layout", format: dateFormat{layout: defaultDateLayout, location: time.UTC}, date: now, expected: "2025-09-17 12:00"},
+		{name: "Custom layout", format: dateFormat{layout: "02 Jan 15:04", location: time.UTC}, date: now, expected: "17 Sep 12:00"},
+		{name: "Converted to the zone", format: dateFormat{layout: defaultDateLayout, location: berlin}, date: now, expected: "2025-09-17 14:00"},
+		{name: "Stored in another zone", format: dateFormat{layout: defaultDateLayout, location: time.UTC}, date: now.In(berlin), expected: "2025-09-17 12:00"},
+		{name: "Zero date", format: dateFormat{layout: defaultDateLayout, location: time.UTC}, date: time.Time{}, expected: "-"},
+		{name: "Just now", format: dateFormat{relative: true}, date: now.Add(-20 * time.Second), expected: "just now"},
+		{name: "Minutes ago", format: dateFormat{relative: true}, date: now.Add(-5 * time.Minute), expected: "5m ago"},
+		{name: "Hours ago", format: dateFormat{relative: true}, date: now.Add(-3*time.Hour - 59*time.Minute), expected: "3h ago"},
+		{name: "Days ago", format: dateFormat{relative: true}, date: now.AddDate(0, 0, -2), expected: "2d ago"},
+		{name: "In the future", format: dateFormat{relative: true}, date: now.Add(90 * time.Minute), expected: "in 1h"},
+		{name: "Too old for relative", format: dateFormat{layout: defaultDateLayout, location: time.UTC, relative: true}, date: now.AddDate(0, -2, 0), expected: "2025-07-17 12:00"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.format.format(tt.date, now))
+		})
+	}
+}
+
+func TestInUTC(t *testing.T) {
+	t.Parallel()
+
+	berlin := loadLocation(t, "Europe/Berlin")
+	created := time.Date(2025, 9, 17, 14, 0, 0, 0, berlin)
+	tasks := []domain.Task{{Id: 1, CreatedAt: created, UpdatedAt: created, DueAt: created.AddDate(0, 0, 1),
+		TimeEntries: []domain.TimeEntry{{StartedAt: created, EndedAt: created.Add(25 * time.Minute)}},
+		Comments: []domain.Comment{
+			{Id: 1, Author: "alice", Body: "Draft is ready", CreatedAt: created, UpdatedAt: created.Add(time.Hour)},
+			{Id: 2, Author: "bob", Body: "Looks good", CreatedAt: created.Add(2 * time.Hour)},
+		}}}
+
+	normalized := inUTC(tasks)
+
+	assert.Equal(t, []domain.Task{{
+		Id:        1,
+		CreatedAt: time.Date(2025, 9, 17, 12, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 9, 17, 12, 0, 0, 0, time.UTC),
+		DueAt:     time.Date(2025, 9, 18, 12, 0, 0, 0, time.UTC),
+		TimeEntries: []domain.TimeEntry{{
+			StartedAt: time.Date(2025, 9, 17, 12, 0, 0, 0, time.UTC),
+			EndedAt:   time.Date(2025, 9, 17, 12, 25, 0, 0, time.UTC),
+		}},
+		Comments: []domain.Comment{
+			{Id: 1, Author: "alice", Body: "Draft is ready", CreatedAt: time.Date(2025, 9, 17, 12, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2025, 9, 17, 13, 0, 0, 0, time.UTC)},
+			{Id: 2, Author: "bob", Body: "Looks good", CreatedAt: time.Date(2025, 9, 17, 14, 0, 0, 0, time.UTC)},
+		},
+	}}, normalized)
+	assert.True(t, normalized[0].CompletedAt.IsZero())
+	assert.Equal(t, berlin, tasks[0].CreatedAt.Location(), "the tasks of the caller are not changed")
+	assert.Equal(t, berlin, tasks[0].TimeEntries[0].StartedAt.Location(), "the time entries of the caller are not changed")
+	assert.Equal(t, berlin, tasks[0].Comments[0].CreatedAt.Location(), "the comments of the caller are not changed")
+}
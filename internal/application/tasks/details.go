@@ -0,0 +1,86 @@
+package tasks
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
+	"strings"
+	"time"
+)
+
+// GetTaskDetails renders every field of a task followed by its comments.
+func GetTaskDetails(id int) (string, error) {
+	return getTaskDetails(defaultTaskStorage, id, time.Now)
+}
+
+func getTaskDetails(storage domain.TaskStorage, id int, now func() time.Time) (string, error) {
+	task, err := findTask(storage, id)
+
+	if err != nil {
+		return "", err
+	}
+
+	return renderTaskDetails(task, now()), nil
+}
+
+func renderTaskDetails(task domain.Task, now time.Time) string {
+	var builder strings.Builder
+
+	field := func(name, value string) {
+		builder.WriteString(fmt.Sprintf("%-12s %s\n", name+":", value))
+	}
+
+	builder.WriteString(fmt.Sprintf("Task %d: %s\n", task.Id, task.Description))
+	field("UUID", task.Uuid)
+	field("Status", taskRenderer.Status(task.CurrentStatus, task.CurrentStatus.String()))
+	field("Priority", task.Priority.String())
+
+	if task.Assignee != "" {
+		field("Assignee", task.Assignee)
+	}
+
+	created := formatDate(task.CreatedAt, now)
+	if task.CreatedBy != "" {
+		created += " by " + task.CreatedBy
+	}
+
+	field("Created", created)
+	field("Updated", formatDate(task.UpdatedAt, now))
+
+	for _, date := range []struct {
+		name string
+		time time.Time
+	}{{"Completed", task.CompletedAt}, {"Due", task.DueAt}, {"Reminder", task.RemindAt}} {
+		if !date.time.IsZero() {
+			field(date.name, formatDate(date.time, now))
+		}
+	}
+
+	if len(task.TimeEntries) > 0 {
+		var logged time.Duration
+		for _, entry := range task.TimeEntries {
+			logged += entry.Duration()
+		}
+
+		field("Time logged", fmt.Sprintf("%s (%s)", formatFocusTime(logged), formatSessions(len(task.TimeEntries))))
+	}
+
+	builder.WriteString("\nComments\n")
+	if len(task.Comments) == 0 {
+		builder.WriteString("  none\n")
+	}
+	for _, comment := range task.Comments {
+		header := fmt.Sprintf("  #%d %s, %s", comment.Id, authorName(comment.Author), formatDate(comment.CreatedAt, now))
+		if !comment.UpdatedAt.IsZero() {
+			header += " (edited)"
+		}
+
+		builder.WriteString(taskRenderer.Paint(renderer.Header, header) + "\n")
+
+		for _, line := range strings.Split(comment.Body, "\n") {
+			builder.WriteString("    " + line + "\n")
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,74 @@
+package tasks
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestGetTaskDetails(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 9, 10, 10, 0, 0, 0, time.UTC)
+	created := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+
+	task := domain.Task{
+		Id:            1,
+		Uuid:          "uuid-1",
+		Description:   "Write report",
+		CurrentStatus: domain.InProgress,
+		Priority:      domain.HighPriority,
+		CreatedAt:     created,
+		UpdatedAt:     now,
+		DueAt:         time.Date(2025, 9, 12, 17, 0, 0, 0, time.UTC),
+		CreatedBy:     "alice",
+		Assignee:      "bob",
+		TimeEntries:   []domain.TimeEntry{{StartedAt: created, EndedAt: created.Add(25 * time.Minute)}},
+		Comments: []domain.Comment{
+			{Id: 1, Author: "alice", Body: "Draft is in the wiki", CreatedAt: created},
+			{Id: 3, Body: "Numbers from Q2\nand Q3", CreatedAt: now, UpdatedAt: now},
+		},
+	}
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	storage.EXPECT().Load().Return([]domain.Task{task}, nil).Times(1)
+
+	res, err := getTaskDetails(storage, 1, func() time.Time { return now })
+
+	expected := "Task 1: Write report\n" +
+		"UUID:        uuid-1\n" +
+		"Status:      in-progress\n" +
+		"Priority:    high\n" +
+		"Assignee:    bob\n" +
+		"Created:     2025-09-01 09:00 by alice\n" +
+		"Updated:     2025-09-10 10:00\n" +
+		"Due:         2025-09-12 17:00\n" +
+		"Time logged: 25m (1 session)\n" +
+		"\nComments\n" +
+		"  #1 alice, 2025-09-01 09:00\n" +
+		"    Draft is in the wiki\n" +
+		"  #3 an unknown user, 2025-09-10 10:00 (edited)\n" +
+		"    Numbers from Q2\n" +
+		"    and Q3\n"
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, res)
+
+	storage.EXPECT().Load().Return([]domain.Task{{Id: 2, Description: "Buy milk", CreatedAt: created, UpdatedAt: created}}, nil).Times(1)
+	res, err = getTaskDetails(storage, 2, func() time.Time { return now })
+
+	assert.NoError(t, err)
+	assert.Contains(t, res, "Created:     2025-09-01 09:00\n")
+	assert.Contains(t, res, "\nComments\n  none\n")
+	assert.NotContains(t, res, "Assignee")
+
+	storage.EXPECT().Load().Return(nil, nil).Times(1)
+	_, err = getTaskDetails(storage, 3, func() time.Time { return now })
+	assert.ErrorIs(t, err, ErrTaskNotFound)
+}
@@ -0,0 +1,131 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	// ErrTaskNotFound is matched (via errors.Is) by every error reporting a missing task.
+	ErrTaskNotFound = errors.New("task not found")
+	// ErrInvalidID is matched by errors reporting a task reference that cannot name a single task.
+	ErrInvalidID = errors.New("invalid task id")
+	// ErrStorage is matched by errors reading or writing the task files.
+	ErrStorage = errors.New("task storage error")
+	// ErrTemplateNotFound is matched by errors reporting a missing task template.
+	ErrTemplateNotFound = errors.New("template not found")
+	// ErrCommentNotFound is matched by errors reporting a missing comment of a task.
+	ErrCommentNotFound = errors.New("comment not found")
+	// ErrInvalidSearch is matched by errors reporting search terms that can not be searched for.
+	ErrInvalidSearch = errors.New("invalid search")
+	// ErrInvalidInput is matched by errors reporting user input that is not valid, such as an unknown status
+	// or format, a malformed date or an empty comment. Invalid task IDs and searches match it as well.
+	ErrInvalidInput = errors.New("invalid input")
+)
+
+type taskNotFoundError struct {
+	ref string
+}
+
+func newTaskNotFoundError(id int) error {
+	return &taskNotFoundError{ref: strconv.Itoa(id)}
+}
+
+func (e *taskNotFoundError) Error() string {
+	return fmt.Sprintf("task with id [%s] not found", e.ref)
+}
+
+func (e *taskNotFoundError) Is(target error) bool {
+	return target == ErrTaskNotFound
+}
+
+type templateNotFoundError struct {
+	name string
+}
+
+func (e *templateNotFoundError) Error() string {
+	return fmt.Sprintf("template %q not found", e.name)
+}
+
+func (e *templateNotFoundError) Is(target error) bool {
+	return target == ErrTemplateNotFound
+}
+
+type commentNotFoundError struct {
+	taskId    int
+	commentId int
+}
+
+func (e *commentNotFoundError) Error() string {
+	return fmt.Sprintf("comment %d not found on task %d", e.commentId, e.taskId)
+}
+
+func (e *commentNotFoundError) Is(target error) bool {
+	return target == ErrCommentNotFound
+}
+
+type invalidIdError struct {
+	message string
+}
+
+func (e *invalidIdError) Error() string {
+	return e.message
+}
+
+func (e *invalidIdError) Is(target error) bool {
+	return target == ErrInvalidID || target == ErrInvalidInput
+}
+
+type invalidSearchError struct {
+	message string
+}
+
+func (e *invalidSearchError) Error() string {
+	return e.message
+}
+
+func (e *invalidSearchError) Is(target error) bool {
+	return target == ErrInvalidSearch || target == ErrInvalidInput
+}
+
+type invalidInputError struct {
+	message string
+}
+
+func newInvalidInputError(format string, args ...any) error {
+	return &invalidInputError{message: fmt.Sprintf(format, args...)}
+}
+
+func (e *invalidInputError) Error() string {
+	return e.message
+}
+
+func (e *invalidInputError) Is(target error) bool {
+	return target == ErrInvalidInput
+}
+
+// storageError wraps the error of the task files, the cause stays available to errors.Is and errors.As.
+type storageError struct {
+	err error
+}
+
+func newStorageError(err error) error {
+	if err == nil || errors.Is(err, ErrStorage) {
+		return err
+	}
+
+	return &storageError{err: err}
+}
+
+func (e *storageError) Error() string {
+	return fmt.Sprintf("task storage error: %s", e.err.Error())
+}
+
+func (e *storageError) Unwrap() error {
+	return e.err
+}
+
+func (e *storageError) Is(target error) bool {
+	return target == ErrStorage
+}
@@ -0,0 +1,63 @@
+package tasks
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io/fs"
+	"testing"
+)
+
+func TestResolveEmptyTaskId(t *testing.T) {
+	t.Parallel()
+
+	_, err := resolveTaskId(nil, "  ")
+
+	assert.EqualError(t, err, "task id is empty")
+	assert.ErrorIs(t, err, ErrInvalidID)
+	assert.NotErrorIs(t, err, ErrTaskNotFound)
+}
+
+func TestResolveMalformedTaskId(t *testing.T) {
+	t.Parallel()
+
+	for _, ref := range []string{"xyz", "-1", "1.5", "3f2a-zz", "#12"} {
+		_, err := resolveTaskId(nil, ref)
+
+		assert.EqualError(t, err, "invalid task reference ["+ref+"]: use a task ID or a UUID prefix")
+		assert.ErrorIs(t, err, ErrInvalidID)
+	}
+}
+
+func TestInvalidInputError(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseStatusString("blocked")
+	assert.EqualError(t, err, "invalid status string: blocked")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	_, err = resolveTaskId(nil, "xyz")
+	assert.ErrorIs(t, err, ErrInvalidInput, "an invalid task ID is invalid input as well")
+
+	_, err = searchTasks(nil, []string{" "}, false)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.ErrorIs(t, err, ErrInvalidSearch)
+
+	assert.NotErrorIs(t, newInvalidInputError("invalid age string: x"), ErrInvalidID)
+}
+
+func TestStorageError(t *testing.T) {
+	t.Parallel()
+
+	cause := &fs.PathError{Op: "open", Path: "tasks.json", Err: fs.ErrPermission}
+	err := newStorageError(cause)
+
+	assert.EqualError(t, err, "task storage error: open tasks.json: permission denied")
+	assert.ErrorIs(t, err, ErrStorage)
+	assert.ErrorIs(t, err, fs.ErrPermission)
+
+	var pathErr *fs.PathError
+	assert.True(t, errors.As(err, &pathErr))
+
+	assert.Same(t, err, newStorageError(err), "an error is wrapped only once")
+	assert.NoError(t, newStorageError(nil))
+}
@@ -0,0 +1,168 @@
+package tasks
+
+import (
+	"encoding/json"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/files"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/hooks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/webhooks"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	webhooksLogFileName = "webhooks.log"
+	hooksDirName        = "hooks"
+	// backgroundQueueSize is the number of events waiting for the background publisher before new ones are dropped.
+	backgroundQueueSize = 64
+)
+
+// configuredWebhooks receive the events of every change, see SetWebhooks.
+var configuredWebhooks []webhooks.Webhook
+
+// publishers forwards events to several publishers. A change is aborted by the first one rejecting it.
+type publishers []domain.EventPublisher
+
+// webhookPublisher delivers events to webhooks and records every delivery attempt in webhooks.log
+// in the save directory.
+type webhookPublisher struct {
+	webhooks []webhooks.Webhook
+}
+
+// backgroundPublisher hands the events to next one by one on its own goroutine, so that slow webhook
+// receivers do not hold up long running callers such as the HTTP API. When the queue is full the event
+// is dropped and the drop is recorded in log, the delivery log of next.
+type backgroundPublisher struct {
+	next   domain.EventPublisher
+	log    io.Writer
+	events chan domain.Event
+	done   chan struct{}
+	mu     sync.Mutex
+	closed bool
+}
+
+// appendLog writes the delivery log lines to a file in the save directory.
+type appendLog struct {
+	fileName string
+}
+
+// defaultEventPublisher returns the publishers for the current save directory.
+func defaultEventPublisher() domain.EventPublisher {
+	return newEventPublishers(&webhookPublisher{webhooks: configuredWebhooks})
+}
+
+// SetWebhooks sets the receivers of the task events.
+func SetWebhooks(configured []webhooks.Webhook) {
+	configuredWebhooks = configured
+}
+
+// newEventPublishers returns the hooks and the git publisher of the current save directory followed by webhooks.
+func newEventPublishers(webhooks domain.EventPublisher) publishers {
+	return publishers{
+		hooks.NewRunner(filepath.Join(files.SaveDir(), hooksDirName), os.Stdout, os.Stderr),
+		&gitPublisher{dir: files.SaveDir()},
+		webhooks,
+	}
+}
+
+func newBackgroundPublisher(next domain.EventPublisher, queueSize int, log io.Writer) *backgroundPublisher {
+	p := &backgroundPublisher{
+		next:   next,
+		log:    log,
+		events: make(chan domain.Event, queueSize),
+		done:   make(chan struct{}),
+	}
+	go p.run()
+
+	return p
+}
+
+func (p publishers) Before(event domain.Event) error {
+	for _, publisher := range p {
+		if err := publisher.Before(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p publishers) Publish(event domain.Event) {
+	for _, publisher := range p {
+		publisher.Publish(event)
+	}
+}
+
+func (p *backgroundPublisher) Before(event domain.Event) error {
+	return p.next.Before(event)
+}
+
+func (p *backgroundPublisher) Publish(event domain.Event) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		p.drop(event, "not delivered, the publisher is closed")
+		return
+	}
+
+	select {
+	case p.events <- event:
+	default:
+		p.drop(event, "not delivered, the delivery queue is full")
+	}
+}
+
+// Close stops taking events and returns once the queued ones are delivered.
+func (p *backgroundPublisher) Close() {
+	p.mu.Lock()
+
+	if !p.closed {
+		p.closed = true
+		close(p.events)
+	}
+
+	p.mu.Unlock()
+	<-p.done
+}
+
+func (p *backgroundPublisher) run() {
+	defer close(p.done)
+
+	for event := range p.events {
+		p.next.Publish(event)
+	}
+}
+
+func (p *backgroundPublisher) drop(event domain.Event, reason string) {
+	line, err := json.Marshal(webhooks.Delivery{Time: time.Now(), Event: string(event.Type), TaskId: event.Task.Id, Error: reason})
+
+	if err != nil {
+		return
+	}
+
+	_, _ = p.log.Write(append(line, '\n'))
+}
+
+func (p *webhookPublisher) Before(event domain.Event) error {
+	return nil
+}
+
+func (p *webhookPublisher) Publish(event domain.Event) {
+	if len(p.webhooks) == 0 {
+		return
+	}
+
+	webhooks.NewDispatcher(p.webhooks, &appendLog{fileName: webhooksLogFileName}).Publish(event)
+}
+
+func (l *appendLog) Write(data []byte) (int, error) {
+	if err := files.AppendToNamedFile(l.fileName, data); err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
@@ -0,0 +1,220 @@
+package tasks
+
+import (
+	"bytes"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestTaskEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	created := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 9, 2, 10, 0, 0, 0, time.UTC)
+
+	existingTask := domain.Task{
+		Id:            1,
+		Uuid:          "3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f",
+		Description:   "Write report",
+		CurrentStatus: domain.InProgress,
+		CreatedAt:     created,
+		UpdatedAt:     created,
+	}
+
+	type testCase struct {
+		name          string
+		beforeErr     error
+		saveErr       error
+		run           func(storage domain.TaskStorage, publisher domain.EventPublisher) error
+		expectedEvent *domain.Event
+	}
+
+	statusChanged := domain.Event{
+		Type: domain.StatusChanged,
+		Task: domain.Task{Id: 1, Uuid: existingTask.Uuid, Description: "Write report", CurrentStatus: domain.Done,
+			CreatedAt: created, UpdatedAt: now, CompletedAt: now},
+		PreviousStatus: domain.InProgress,
+		OccurredAt:     now,
+	}
+
+	tests := []testCase{
+		{
+			name: "Add emits TaskCreated",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				_, err := addTask(storage, publisher, "Plan trip", "alice", func() time.Time { return now }, func() string { return "uuid" })
+				return err
+			},
+			expectedEvent: &domain.Event{
+				Type:       domain.TaskCreated,
+				Task:       domain.Task{Id: 2, Uuid: "uuid", Description: "Plan trip", CurrentStatus: domain.Todo, CreatedAt: now, UpdatedAt: now, CreatedBy: "alice", Assignee: "alice"},
+				OccurredAt: now,
+			},
+		},
+		{
+			name: "Update emits TaskUpdated",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				return updateTask(storage, publisher, 1, "Send report", func() time.Time { return now })
+			},
+			expectedEvent: &domain.Event{
+				Type: domain.TaskUpdated,
+				Task: domain.Task{Id: 1, Uuid: existingTask.Uuid, Description: "Send report", CurrentStatus: domain.InProgress,
+					CreatedAt: created, UpdatedAt: now},
+				OccurredAt: now,
+			},
+		},
+		{
+			name: "Status change emits StatusChanged with the previous status",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				return updateTaskStatus(storage, publisher, 1, domain.Done, func() time.Time { return now })
+			},
+			expectedEvent: &statusChanged,
+		},
+		{
+			name: "Description and status change emit a single StatusChanged",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				description, status := "Send report", domain.Done
+				task, err := editTask(storage, publisher, 1, &description, &status, func() time.Time { return now })
+				assert.Equal(t, "Send report", task.Description)
+				return err
+			},
+			expectedEvent: &domain.Event{
+				Type: domain.StatusChanged,
+				Task: domain.Task{Id: 1, Uuid: existingTask.Uuid, Description: "Send report", CurrentStatus: domain.Done,
+					CreatedAt: created, UpdatedAt: now, CompletedAt: now},
+				PreviousStatus: domain.InProgress,
+				OccurredAt:     now,
+			},
+		},
+		{
+			name: "Description with an unchanged status emits TaskUpdated",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				description, status := "Send report", domain.InProgress
+				_, err := editTask(storage, publisher, 1, &description, &status, func() time.Time { return now })
+				return err
+			},
+			expectedEvent: &domain.Event{
+				Type: domain.TaskUpdated,
+				Task: domain.Task{Id: 1, Uuid: existingTask.Uuid, Description: "Send report", CurrentStatus: domain.InProgress,
+					CreatedAt: created, UpdatedAt: now},
+				OccurredAt: now,
+			},
+		},
+		{
+			name: "Unchanged status publishes nothing",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				return updateTaskStatus(storage, publisher, 1, domain.InProgress, func() time.Time { return now })
+			},
+		},
+		{
+			name: "Delete emits TaskDeleted with the removed task",
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				return deleteTask(storage, publisher, 1, func() time.Time { return now })
+			},
+			expectedEvent: &domain.Event{Type: domain.TaskDeleted, Task: existingTask, OccurredAt: now},
+		},
+		{
+			name:    "Failed save publishes nothing",
+			saveErr: assert.AnError,
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				return updateTaskStatus(storage, publisher, 1, domain.Done, func() time.Time { return now })
+			},
+			expectedEvent: &statusChanged,
+		},
+		{
+			name:      "Rejected change is not saved",
+			beforeErr: assert.AnError,
+			run: func(storage domain.TaskStorage, publisher domain.EventPublisher) error {
+				return updateTaskStatus(storage, publisher, 1, domain.Done, func() time.Time { return now })
+			},
+			expectedEvent: &statusChanged,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewMockTaskStorage(ctrl)
+			storage.EXPECT().Load().Return([]domain.Task{existingTask}, nil).Times(1)
+			storage.EXPECT().NextId().Return(2, nil).AnyTimes()
+			publisher := mocks.NewMockEventPublisher(ctrl)
+
+			if tt.beforeErr == nil {
+				storage.EXPECT().Save(gomock.Any()).Return(tt.saveErr).Times(1)
+			}
+
+			if tt.expectedEvent != nil {
+				publisher.EXPECT().Before(gomock.Eq(*tt.expectedEvent)).Return(tt.beforeErr).Times(1)
+
+				if tt.beforeErr == nil && tt.saveErr == nil {
+					publisher.EXPECT().Publish(gomock.Eq(*tt.expectedEvent)).Times(1)
+				}
+			}
+
+			err := tt.run(storage, publisher)
+
+			if tt.beforeErr != nil {
+				assert.EqualError(t, err, tt.beforeErr.Error())
+			} else if tt.saveErr != nil {
+				assert.EqualError(t, err, tt.saveErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// blockingPublisher records the published events once release is closed.
+type blockingPublisher struct {
+	release   chan struct{}
+	published chan domain.Event
+}
+
+func (p *blockingPublisher) Before(domain.Event) error {
+	return nil
+}
+
+func (p *blockingPublisher) Publish(event domain.Event) {
+	<-p.release
+	p.published <- event
+}
+
+func TestBackgroundPublisher(t *testing.T) {
+	t.Parallel()
+
+	var log bytes.Buffer
+	next := &blockingPublisher{release: make(chan struct{}), published: make(chan domain.Event, 3)}
+	publisher := newBackgroundPublisher(next, 1, &log)
+
+	first := domain.Event{Type: domain.TaskCreated, Task: domain.Task{Id: 1}}
+	second := domain.Event{Type: domain.StatusChanged, Task: domain.Task{Id: 1}}
+	third := domain.Event{Type: domain.TaskDeleted, Task: domain.Task{Id: 1}}
+
+	// Publish returns while the receiver is still busy, the first event is taken and the second one waits.
+	publisher.Publish(first)
+	require.Eventually(t, func() bool { return len(publisher.events) == 0 }, time.Second, time.Millisecond)
+	publisher.Publish(second)
+
+	// The queue is full, the third event is dropped without blocking.
+	publisher.Publish(third)
+	assert.Contains(t, log.String(), `"event":"task.deleted","taskId":1,"error":"not delivered, the delivery queue is full"}`)
+
+	close(next.release)
+	publisher.Close()
+
+	require.Len(t, next.published, 2, "Close returns once the queued events are delivered")
+	assert.Equal(t, first, <-next.published)
+	assert.Equal(t, second, <-next.published)
+
+	publisher.Publish(third)
+	assert.Contains(t, log.String(), `"error":"not delivered, the publisher is closed"}`)
+}
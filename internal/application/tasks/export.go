@@ -0,0 +1,316 @@
+package tasks
+
+import (
+	"encoding/csv"
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	MarkdownFormat  = "markdown"
+	ICalendarFormat = "ics"
+)
+
+const (
+	icsDateTimeLayout = "20060102T150405Z"
+	icsLineLimit      = 75
+)
+
+// ExportTasks writes every task to w in the given format.
+func ExportTasks(format string, w io.Writer) error {
+	return exportTasks(defaultTaskStorage, format, w, Now)
+}
+
+func exportTasks(taskStorage domain.TaskStorage, format string, w io.Writer, now func() time.Time) error {
+	var write func(w io.Writer, tasks []domain.Task, now time.Time) error
+
+	switch strings.ToLower(format) {
+	case TodoTxtFormat:
+		write = writeTodoTxt
+	case MarkdownFormat:
+		write = writeMarkdown
+	case ICalendarFormat:
+		write = writeICalendar
+	case CsvFormat:
+		write = writeCsv
+	default:
+		return newInvalidInputError("invalid export format: %s", format)
+	}
+
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return err
+	}
+
+	return write(w, tasks, now())
+}
+
+func writeTodoTxt(w io.Writer, tasks []domain.Task, now time.Time) error {
+	loc := now.Location()
+
+	for _, task := range tasks {
+		var fields []string
+		priority := todoTxtPriority(task.Priority)
+
+		if completedAt, ok := completionTime(task); ok {
+			fields = append(fields, "x", completedAt.In(loc).Format(importDateLayout))
+		} else if priority != "" {
+			fields = append(fields, "("+priority+")")
+		}
+
+		fields = append(fields, task.CreatedAt.In(loc).Format(importDateLayout), task.Description)
+
+		if !task.DueAt.IsZero() {
+			fields = append(fields, "due:"+task.DueAt.In(loc).Format(importDateLayout))
+		}
+
+		// Completed todo.txt tasks drop the leading priority, it is kept as a tag instead.
+		if task.CurrentStatus == domain.Done && priority != "" {
+			fields = append(fields, "pri:"+priority)
+		}
+
+		if _, err := fmt.Fprintln(w, strings.Join(fields, " ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func todoTxtPriority(priority domain.Priority) string {
+	switch priority {
+	case domain.HighPriority:
+		return "A"
+	case domain.MediumPriority:
+		return "B"
+	case domain.LowPriority:
+		return "C"
+	default:
+		return ""
+	}
+}
+
+// writeMarkdown renders a checklist with one section per status.
+func writeMarkdown(w io.Writer, tasks []domain.Task, now time.Time) error {
+	sections := []struct {
+		title  string
+		status domain.Status
+	}{
+		{"Todo", domain.Todo},
+		{"In progress", domain.InProgress},
+		{"Done", domain.Done},
+	}
+
+	var builder strings.Builder
+	loc := now.Location()
+
+	for _, section := range sections {
+		var items []string
+
+		for _, task := range tasks {
+			if task.CurrentStatus != section.status {
+				continue
+			}
+
+			checkbox := "[ ]"
+			if task.CurrentStatus == domain.Done {
+				checkbox = "[x]"
+			}
+
+			details := []string{fmt.Sprintf("#%d", task.Id)}
+			if !task.DueAt.IsZero() {
+				details = append(details, "due "+task.DueAt.In(loc).Format(importDateLayout))
+			}
+			if task.Priority != domain.NoPriority {
+				details = append(details, "priority "+task.Priority.String())
+			}
+
+			items = append(items, fmt.Sprintf("- %s %s (%s)\n", checkbox, escapeMarkdown(task.Description), strings.Join(details, ", ")))
+		}
+
+		if len(items) == 0 {
+			continue
+		}
+
+		if builder.Len() > 0 {
+			builder.WriteString("\n")
+		}
+
+		builder.WriteString("## " + section.title + "\n\n")
+		for _, item := range items {
+			builder.WriteString(item)
+		}
+	}
+
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+func escapeMarkdown(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`).Replace(text)
+}
+
+// writeICalendar writes an RFC 5545 calendar with one VTODO per task.
+func writeICalendar(w io.Writer, tasks []domain.Task, now time.Time) error {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//TaskTracker-CLI//EN",
+	}
+
+	for _, task := range tasks {
+		uid := task.Uuid
+		if uid == "" {
+			uid = fmt.Sprintf("task-%d", task.Id)
+		}
+
+		lines = append(lines,
+			"BEGIN:VTODO",
+			"UID:"+uid,
+			"DTSTAMP:"+formatIcsTime(now),
+			"CREATED:"+formatIcsTime(task.CreatedAt),
+			"LAST-MODIFIED:"+formatIcsTime(task.UpdatedAt),
+			"SUMMARY:"+escapeIcsText(task.Description),
+			"STATUS:"+icsStatus(task.CurrentStatus),
+		)
+
+		if completedAt, ok := completionTime(task); ok {
+			lines = append(lines, "COMPLETED:"+formatIcsTime(completedAt))
+		}
+
+		if !task.DueAt.IsZero() {
+			lines = append(lines, "DUE:"+formatIcsTime(task.DueAt))
+		}
+
+		if priority := icsPriority(task.Priority); priority != 0 {
+			lines = append(lines, "PRIORITY:"+strconv.Itoa(priority))
+		}
+
+		if !task.RemindAt.IsZero() {
+			lines = append(lines,
+				"BEGIN:VALARM",
+				"ACTION:DISPLAY",
+				"DESCRIPTION:"+escapeIcsText(task.Description),
+				"TRIGGER;VALUE=DATE-TIME:"+formatIcsTime(task.RemindAt),
+				"END:VALARM",
+			)
+		}
+
+		lines = append(lines, "END:VTODO")
+	}
+
+	lines = append(lines, "END:VCALENDAR")
+
+	var builder strings.Builder
+	for _, line := range lines {
+		builder.WriteString(foldIcsLine(line))
+		builder.WriteString("\r\n")
+	}
+
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+func formatIcsTime(t time.Time) string {
+	return t.UTC().Format(icsDateTimeLayout)
+}
+
+func icsStatus(status domain.Status) string {
+	switch status {
+	case domain.InProgress:
+		return "IN-PROCESS"
+	case domain.Done:
+		return "COMPLETED"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+// icsPriority maps priorities onto the 1 (highest) to 9 (lowest) iCalendar scale, 0 means undefined.
+func icsPriority(priority domain.Priority) int {
+	switch priority {
+	case domain.HighPriority:
+		return 1
+	case domain.MediumPriority:
+		return 5
+	case domain.LowPriority:
+		return 9
+	default:
+		return 0
+	}
+}
+
+func escapeIcsText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// foldIcsLine splits lines longer than 75 octets into continuation lines without breaking UTF-8 sequences.
+func foldIcsLine(line string) string {
+	var builder strings.Builder
+	width := 0
+
+	for _, r := range line {
+		size := len(string(r))
+
+		if width+size > icsLineLimit {
+			builder.WriteString("\r\n ")
+			width = 1
+		}
+
+		builder.WriteRune(r)
+		width += size
+	}
+
+	return builder.String()
+}
+
+func writeCsv(w io.Writer, tasks []domain.Task, now time.Time) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"ID", "UUID", "Description", "Status", "Priority", "Due", "Created", "Completed"}); err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		priority := ""
+		if task.Priority != domain.NoPriority {
+			priority = task.Priority.String()
+		}
+
+		completed := ""
+		if completedAt, ok := completionTime(task); ok {
+			completed = formatCsvTime(completedAt)
+		}
+
+		record := []string{
+			strconv.Itoa(task.Id),
+			task.Uuid,
+			task.Description,
+			task.CurrentStatus.String(),
+			priority,
+			formatCsvTime(task.DueAt),
+			formatCsvTime(task.CreatedAt),
+			completed,
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatCsvTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,130 @@
+package tasks
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files in testdata")
+
+var exportNow = time.Date(2025, 9, 30, 12, 0, 0, 0, time.UTC)
+
+var exportFixtureTasks = []domain.Task{
+	{
+		Id: 1, Uuid: "3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f", Description: "Buy groceries",
+		CurrentStatus: domain.Todo, Priority: domain.HighPriority,
+		CreatedAt: time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC),
+		DueAt:    time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+		RemindAt: time.Date(2025, 9, 30, 9, 0, 0, 0, time.UTC),
+	},
+	{
+		Id: 2, Uuid: "9b0e7d2a-51c4-4e8f-a3b6-0c1d2e3f4a5b", Description: "Review PR #42, then merge; see [notes]",
+		CurrentStatus: domain.InProgress,
+		CreatedAt:     time.Date(2025, 9, 2, 10, 30, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 3, 8, 0, 0, 0, time.UTC),
+	},
+	{
+		Id: 4, Uuid: "c4d5e6f7-0a1b-4c2d-8e3f-4a5b6c7d8e9f", Description: "Write release notes",
+		CurrentStatus: domain.Done, Priority: domain.MediumPriority,
+		CreatedAt: time.Date(2025, 9, 4, 14, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 6, 16, 0, 0, 0, time.UTC),
+		CompletedAt: time.Date(2025, 9, 6, 16, 0, 0, 0, time.UTC),
+	},
+	{
+		Id: 7, Description: "A very long task description that needs folding in iCalendar output, really",
+		CurrentStatus: domain.Todo, Priority: domain.LowPriority,
+		CreatedAt: time.Date(2025, 9, 10, 7, 15, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 10, 7, 15, 0, 0, time.UTC),
+	},
+}
+
+func TestExportTasksGolden(t *testing.T) {
+	t.Parallel()
+
+	formats := map[string]string{
+		TodoTxtFormat:   "export.todotxt.golden",
+		MarkdownFormat:  "export.md.golden",
+		ICalendarFormat: "export.ics.golden",
+		CsvFormat:       "export.csv.golden",
+	}
+
+	for format, goldenFile := range formats {
+		format, goldenFile := format, goldenFile
+		t.Run(format, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mocks.NewMockTaskStorage(ctrl)
+			storage.EXPECT().Load().Return(exportFixtureTasks, nil).Times(1)
+
+			var out bytes.Buffer
+			err := exportTasks(storage, format, &out, func() time.Time { return exportNow })
+			assert.NoError(t, err)
+
+			goldenPath := filepath.Join("testdata", goldenFile)
+
+			if *updateGolden {
+				assert.NoError(t, os.WriteFile(goldenPath, out.Bytes(), 0644))
+			}
+
+			expected, err := os.ReadFile(goldenPath)
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), out.String())
+		})
+	}
+}
+
+func TestExportTasksErrors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+
+	err := exportTasks(storage, "xml", &bytes.Buffer{}, func() time.Time { return exportNow })
+	assert.EqualError(t, err, fmt.Sprintf("invalid export format: %s", "xml"))
+
+	storage.EXPECT().Load().Return(nil, assert.AnError).Times(1)
+
+	err = exportTasks(storage, CsvFormat, &bytes.Buffer{}, func() time.Time { return exportNow })
+	assert.EqualError(t, err, assert.AnError.Error())
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{TodoTxtFormat, CsvFormat} {
+		format := format
+		t.Run(format, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			write := map[string]func(*bytes.Buffer) error{
+				TodoTxtFormat: func(b *bytes.Buffer) error { return writeTodoTxt(b, exportFixtureTasks, exportNow) },
+				CsvFormat:     func(b *bytes.Buffer) error { return writeCsv(b, exportFixtureTasks, exportNow) },
+			}[format]
+			assert.NoError(t, write(&out))
+
+			parsed, issues, err := parseImport(format, strings.NewReader(out.String()), exportNow)
+			assert.NoError(t, err)
+			assert.Empty(t, issues)
+			assert.Len(t, parsed, len(exportFixtureTasks))
+
+			for i, task := range parsed {
+				assert.Equal(t, exportFixtureTasks[i].Description, task.Description)
+				assert.Equal(t, exportFixtureTasks[i].Priority, task.Priority)
+				assert.True(t, exportFixtureTasks[i].DueAt.Equal(task.DueAt))
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package tasks
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FocusPlan describes a cycle of focus sessions and the breaks between them.
+type FocusPlan struct {
+	Focus      time.Duration
+	ShortBreak time.Duration
+	LongBreak  time.Duration
+	// LongBreakEvery is the number of focus sessions after which the break is a long one.
+	LongBreakEvery int
+}
+
+// DefaultFocusPlan is the classic pomodoro cycle: 25 minutes of focus, 5 minute breaks
+// and a 15 minute break after every fourth session.
+var DefaultFocusPlan = FocusPlan{
+	Focus:          25 * time.Minute,
+	ShortBreak:     5 * time.Minute,
+	LongBreak:      15 * time.Minute,
+	LongBreakEvery: 4,
+}
+
+type focusTotal struct {
+	sessions int
+	time     time.Duration
+}
+
+type taskFocus struct {
+	task domain.Task
+	focusTotal
+}
+
+type focusReport struct {
+	today   focusTotal
+	week    focusTotal
+	allTime focusTotal
+	tasks   []taskFocus
+}
+
+// BreakAfter returns the break that follows the focus session with the given 1-based number
+// and whether it is a long one.
+func (p FocusPlan) BreakAfter(session int) (time.Duration, bool) {
+	if p.LongBreakEvery > 0 && session%p.LongBreakEvery == 0 {
+		return p.LongBreak, true
+	}
+
+	return p.ShortBreak, false
+}
+
+// StartFocus marks the task as in progress, the way every focus session on it begins, and returns it.
+func StartFocus(id int) (domain.Task, error) {
+	return startFocus(defaultTaskStorage, defaultEventPublisher(), id, time.Now)
+}
+
+// LogFocusSession records a completed focus session as a time entry on the task.
+func LogFocusSession(id int, startedAt, endedAt time.Time) error {
+	return logTimeEntry(defaultTaskStorage, defaultEventPublisher(), id,
+		domain.TimeEntry{StartedAt: startedAt, EndedAt: endedAt}, time.Now)
+}
+
+// GetFocusStats renders the focus time of today, this week and overall, and of every task this week.
+func GetFocusStats() (string, error) {
+	return getFocusStats(defaultTaskStorage, Now)
+}
+
+func startFocus(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id int, now func() time.Time) (domain.Task, error) {
+	task, err := findTask(taskStorage, id)
+
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	if task.CurrentStatus == domain.InProgress {
+		return task, nil
+	}
+
+	if err := updateTaskStatus(taskStorage, publisher, id, domain.InProgress, now); err != nil {
+		return domain.Task{}, err
+	}
+
+	return findTask(taskStorage, id)
+}
+
+func logTimeEntry(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id int, entry domain.TimeEntry, now func() time.Time) error {
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return err
+	}
+
+	for i := range tasks {
+		if tasks[i].Id == id {
+			tasks[i].TimeEntries = append(tasks[i].TimeEntries, entry)
+			tasks[i].UpdatedAt = now()
+			event := domain.Event{Type: domain.TaskUpdated, Task: tasks[i], OccurredAt: now()}
+
+			if err := publisher.Before(event); err != nil {
+				return err
+			}
+
+			if err := taskStorage.Save(tasks); err != nil {
+				return err
+			}
+
+			publisher.Publish(event)
+			return nil
+		}
+	}
+
+	return newTaskNotFoundError(id)
+}
+
+func findTask(taskStorage domain.TaskStorage, id int) (domain.Task, error) {
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	for _, task := range tasks {
+		if task.Id == id {
+			return task, nil
+		}
+	}
+
+	return domain.Task{}, newTaskNotFoundError(id)
+}
+
+func getFocusStats(storage domain.TaskStorage, now func() time.Time) (string, error) {
+	tasks, err := storage.Load()
+
+	if err != nil {
+		return "", err
+	}
+
+	return renderFocusReport(buildFocusReport(tasks, now())), nil
+}
+
+// buildFocusReport sums the time entries by the day and week they started in, in the zone of now.
+func buildFocusReport(tasks []domain.Task, now time.Time) focusReport {
+	today := startOfDay(now)
+	week := startOfWeek(now)
+
+	var report focusReport
+
+	for _, task := range tasks {
+		current := taskFocus{task: task}
+
+		for _, entry := range task.TimeEntries {
+			report.allTime.add(entry)
+
+			if inRange(entry.StartedAt, today, today.AddDate(0, 0, 1)) {
+				report.today.add(entry)
+			}
+
+			if inRange(entry.StartedAt, week, week.AddDate(0, 0, 7)) {
+				report.week.add(entry)
+				current.add(entry)
+			}
+		}
+
+		if current.sessions > 0 {
+			report.tasks = append(report.tasks, current)
+		}
+	}
+
+	sort.SliceStable(report.tasks, func(i, j int) bool {
+		return report.tasks[i].time > report.tasks[j].time
+	})
+
+	return report
+}
+
+func (t *focusTotal) add(entry domain.TimeEntry) {
+	t.sessions++
+	t.time += entry.Duration()
+}
+
+func renderFocusReport(report focusReport) string {
+	var builder strings.Builder
+
+	builder.WriteString("Focus sessions\n")
+	for _, period := range []struct {
+		name  string
+		total focusTotal
+	}{{"Today", report.today}, {"This week", report.week}, {"All time", report.allTime}} {
+		builder.WriteString(fmt.Sprintf("  %-12s %-12s %s\n", period.name, formatSessions(period.total.sessions), formatFocusTime(period.total.time)))
+	}
+
+	builder.WriteString("\nFocus time this week by task\n")
+	if len(report.tasks) == 0 {
+		builder.WriteString("  none\n")
+	}
+	for _, task := range report.tasks {
+		builder.WriteString(fmt.Sprintf("  %-3d %-20s %-8s (%s)\n",
+			task.task.Id, task.task.Description, formatFocusTime(task.time), formatSessions(task.sessions)))
+	}
+
+	return builder.String()
+}
+
+func formatSessions(count int) string {
+	if count == 1 {
+		return "1 session"
+	}
+
+	return fmt.Sprintf("%d sessions", count)
+}
+
+// formatFocusTime renders a duration in hours and minutes, focus sessions are planned in minutes.
+func formatFocusTime(d time.Duration) string {
+	minutes := int((d + time.Minute/2) / time.Minute)
+
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
@@ -0,0 +1,187 @@
+package tasks
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestFocusPlanBreakAfter(t *testing.T) {
+	t.Parallel()
+
+	plan := DefaultFocusPlan
+
+	for session, expectedLong := range map[int]bool{1: false, 3: false, 4: true, 5: false, 8: true} {
+		duration, long := plan.BreakAfter(session)
+
+		assert.Equal(t, expectedLong, long, "session %d", session)
+		if expectedLong {
+			assert.Equal(t, 15*time.Minute, duration)
+		} else {
+			assert.Equal(t, 5*time.Minute, duration)
+		}
+	}
+
+	duration, long := FocusPlan{ShortBreak: time.Minute}.BreakAfter(4)
+	assert.False(t, long, "without LongBreakEvery every break is short")
+	assert.Equal(t, time.Minute, duration)
+}
+
+func TestStartFocus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 9, 10, 10, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name        string
+		task        domain.Task
+		id          int
+		expected    domain.Task
+		expectSave  bool
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:       "Todo task is started",
+			task:       domain.Task{Id: 1, Description: "Write report", CurrentStatus: domain.Todo},
+			id:         1,
+			expected:   domain.Task{Id: 1, Description: "Write report", CurrentStatus: domain.InProgress, UpdatedAt: now},
+			expectSave: true,
+		},
+		{
+			name:     "Task in progress is left unchanged",
+			task:     domain.Task{Id: 1, Description: "Write report", CurrentStatus: domain.InProgress},
+			id:       1,
+			expected: domain.Task{Id: 1, Description: "Write report", CurrentStatus: domain.InProgress},
+		},
+		{
+			name:        "Missing task",
+			task:        domain.Task{Id: 1},
+			id:          2,
+			expectedErr: ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mocks.NewMockTaskStorage(ctrl)
+			publisher := mocks.NewMockEventPublisher(ctrl)
+
+			if tt.expectSave {
+				event := domain.Event{Type: domain.StatusChanged, Task: tt.expected, PreviousStatus: tt.task.CurrentStatus, OccurredAt: now}
+
+				gomock.InOrder(
+					storage.EXPECT().Load().Return([]domain.Task{tt.task}, nil),
+					storage.EXPECT().Load().Return([]domain.Task{tt.task}, nil),
+					publisher.EXPECT().Before(event).Return(nil),
+					storage.EXPECT().Save([]domain.Task{tt.expected}).Return(nil),
+					publisher.EXPECT().Publish(event),
+					storage.EXPECT().Load().Return([]domain.Task{tt.expected}, nil),
+				)
+			} else {
+				storage.EXPECT().Load().Return([]domain.Task{tt.task}, nil).Times(1)
+			}
+
+			task, err := startFocus(storage, publisher, tt.id, func() time.Time { return now })
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, task)
+		})
+	}
+}
+
+func TestLogTimeEntry(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 9, 10, 10, 25, 0, 0, time.UTC)
+	earlier := domain.TimeEntry{StartedAt: now.Add(-time.Hour), EndedAt: now.Add(-35 * time.Minute)}
+	entry := domain.TimeEntry{StartedAt: now.Add(-25 * time.Minute), EndedAt: now}
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	storage.EXPECT().Load().Return([]domain.Task{{Id: 1, Description: "Write report", TimeEntries: []domain.TimeEntry{earlier}}}, nil).Times(1)
+	expected := []domain.Task{{Id: 1, Description: "Write report", UpdatedAt: now, TimeEntries: []domain.TimeEntry{earlier, entry}}}
+	event := domain.Event{Type: domain.TaskUpdated, Task: expected[0], OccurredAt: now}
+	publisher.EXPECT().Before(event).Return(nil).Times(1)
+	storage.EXPECT().Save(expected).Return(nil).Times(1)
+	publisher.EXPECT().Publish(event).Times(1)
+
+	err := logTimeEntry(storage, publisher, 1, entry, func() time.Time { return now })
+	assert.NoError(t, err)
+
+	storage.EXPECT().Load().Return([]domain.Task{{Id: 1}}, nil).Times(1)
+	err = logTimeEntry(storage, publisher, 2, entry, func() time.Time { return now })
+	assert.ErrorIs(t, err, ErrTaskNotFound)
+}
+
+func TestGetFocusStats(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Wednesday, the week started on Monday the 15th.
+	now := time.Date(2025, 9, 17, 18, 0, 0, 0, time.UTC)
+	session := func(day, hour, minutes int) domain.TimeEntry {
+		start := time.Date(2025, 9, day, hour, 0, 0, 0, time.UTC)
+		return domain.TimeEntry{StartedAt: start, EndedAt: start.Add(time.Duration(minutes) * time.Minute)}
+	}
+
+	tasks := []domain.Task{
+		{Id: 1, Description: "Write report", TimeEntries: []domain.TimeEntry{session(17, 9, 25), session(17, 10, 25), session(15, 9, 25)}},
+		{Id: 2, Description: "Review PR", TimeEntries: []domain.TimeEntry{session(16, 14, 50), session(16, 15, 50)}},
+		{Id: 3, Description: "Old work", TimeEntries: []domain.TimeEntry{session(10, 9, 25)}},
+		{Id: 4, Description: "Buy milk"},
+	}
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	storage.EXPECT().Load().Return(tasks, nil).Times(1)
+
+	res, err := getFocusStats(storage, func() time.Time { return now })
+
+	expected := "Focus sessions\n" +
+		"  Today        2 sessions   50m\n" +
+		"  This week    5 sessions   2h 55m\n" +
+		"  All time     6 sessions   3h 20m\n" +
+		"\nFocus time this week by task\n" +
+		"  2   Review PR            1h 40m   (2 sessions)\n" +
+		"  1   Write report         1h 15m   (3 sessions)\n"
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, res)
+
+	storage.EXPECT().Load().Return([]domain.Task{{Id: 1}}, nil).Times(1)
+	res, err = getFocusStats(storage, func() time.Time { return now })
+
+	assert.NoError(t, err)
+	assert.Contains(t, res, "  Today        0 sessions   0m\n")
+	assert.Contains(t, res, "Focus time this week by task\n  none\n")
+}
+
+func TestFormatFocusTime(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0m", formatFocusTime(0))
+	assert.Equal(t, "25m", formatFocusTime(24*time.Minute+45*time.Second))
+	assert.Equal(t, "1h 00m", formatFocusTime(time.Hour))
+	assert.Equal(t, "12h 05m", formatFocusTime(12*time.Hour+5*time.Minute))
+}
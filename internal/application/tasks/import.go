@@ -0,0 +1,590 @@
+package tasks
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	TodoTxtFormat     = "todotxt"
+	TaskwarriorFormat = "taskwarrior"
+	CsvFormat         = "csv"
+)
+
+const (
+	importDateLayout     = "2006-01-02"
+	importDateTimeLayout = "2006-01-02 15:04"
+	taskwarriorLayout    = "20060102T150405Z"
+)
+
+// importIssue describes an input line that could not be imported.
+type importIssue struct {
+	line   int
+	reason string
+}
+
+// ImportTasks reads tasks in the given format and adds them to the task list with fresh IDs.
+// The current user creates the imported tasks and is assigned them, like tasks added by hand.
+// With dryRun nothing is saved and the report previews the tasks that would be imported.
+func ImportTasks(format string, r io.Reader, dryRun bool) (string, error) {
+	return importTasks(defaultTaskStorage, defaultEventPublisher(), format, r, dryRun, currentUser, Now, newUuid)
+}
+
+func importTasks(taskStorage domain.TaskStorage, publisher domain.EventPublisher, format string, r io.Reader, dryRun bool,
+	user string, now func() time.Time, newUuid func() string) (string, error) {
+	importTime := now()
+	parsed, issues, err := parseImport(format, r, importTime)
+
+	if err != nil {
+		return "", err
+	}
+
+	if dryRun || len(parsed) == 0 {
+		return renderImportReport(parsed, issues, dryRun), nil
+	}
+
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return "", err
+	}
+
+	firstId, err := taskStorage.NextId()
+
+	if err != nil {
+		return "", err
+	}
+
+	events := make([]domain.Event, 0, len(parsed))
+
+	for i := range parsed {
+		parsed[i].Id = firstId + i
+		parsed[i].Uuid = newUuid()
+		parsed[i].CreatedBy = user
+		parsed[i].Assignee = user
+
+		event := domain.Event{Type: domain.TaskCreated, Task: parsed[i], OccurredAt: importTime}
+
+		if err := publisher.Before(event); err != nil {
+			return "", err
+		}
+
+		events = append(events, event)
+	}
+
+	if err := taskStorage.Save(append(tasks, parsed...)); err != nil {
+		return "", err
+	}
+
+	for _, event := range events {
+		publisher.Publish(event)
+	}
+
+	return renderImportReport(parsed, issues, false), nil
+}
+
+func parseImport(format string, r io.Reader, now time.Time) ([]domain.Task, []importIssue, error) {
+	var (
+		parsed []domain.Task
+		issues []importIssue
+		err    error
+	)
+
+	switch strings.ToLower(format) {
+	case TodoTxtFormat:
+		parsed, issues, err = parseTodoTxt(r, now.Location())
+	case TaskwarriorFormat:
+		parsed, issues, err = parseTaskwarrior(r)
+	case CsvFormat:
+		parsed, issues, err = parseCsv(r, now.Location())
+	default:
+		return nil, nil, newInvalidInputError("invalid import format: %s", format)
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i := range parsed {
+		completeImportedTask(&parsed[i], now)
+	}
+
+	return parsed, issues, nil
+}
+
+// completeImportedTask fills in the timestamps the source format did not provide.
+func completeImportedTask(task *domain.Task, now time.Time) {
+	if task.CreatedAt.IsZero() {
+		task.CreatedAt = now
+	}
+
+	if task.UpdatedAt.IsZero() {
+		task.UpdatedAt = task.CreatedAt
+
+		if task.CompletedAt.After(task.UpdatedAt) {
+			task.UpdatedAt = task.CompletedAt
+		}
+	}
+
+	if task.CurrentStatus != domain.Done {
+		task.CompletedAt = time.Time{}
+	}
+}
+
+func parseTodoTxt(r io.Reader, loc *time.Location) ([]domain.Task, []importIssue, error) {
+	var (
+		parsed []domain.Task
+		issues []importIssue
+	)
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+
+		if text == "" {
+			continue
+		}
+
+		task, err := parseTodoTxtLine(text, loc)
+
+		if err != nil {
+			issues = append(issues, importIssue{line: line, reason: err.Error()})
+			continue
+		}
+
+		parsed = append(parsed, task)
+	}
+
+	return parsed, issues, scanner.Err()
+}
+
+// parseTodoTxtLine parses a single todo.txt line, e.g.
+// "x 2025-09-02 2025-09-01 Buy milk due:2025-09-03" or "(A) 2025-09-01 Call mom +family".
+func parseTodoTxtLine(text string, loc *time.Location) (domain.Task, error) {
+	fields := strings.Fields(text)
+	task := domain.Task{CurrentStatus: domain.Todo}
+
+	if fields[0] == "x" {
+		task.CurrentStatus = domain.Done
+		fields = fields[1:]
+
+		if date, ok := parseLeadingDate(fields, loc); ok {
+			task.CompletedAt = date
+			fields = fields[1:]
+		}
+	} else if priority, ok := parseTodoTxtPriority(fields[0]); ok {
+		task.Priority = priority
+		fields = fields[1:]
+	}
+
+	if date, ok := parseLeadingDate(fields, loc); ok {
+		task.CreatedAt = date
+		fields = fields[1:]
+	}
+
+	var words []string
+
+	for _, field := range fields {
+		key, value, ok := strings.Cut(field, ":")
+
+		switch {
+		case ok && key == "due":
+			due, err := time.ParseInLocation(importDateLayout, value, loc)
+
+			if err != nil {
+				return domain.Task{}, fmt.Errorf("invalid due date %q", value)
+			}
+
+			task.DueAt = due
+		case ok && key == "pri" && task.CurrentStatus == domain.Done:
+			if priority, ok := parseTodoTxtPriority("(" + value + ")"); ok {
+				task.Priority = priority
+			}
+		default:
+			words = append(words, field)
+		}
+	}
+
+	if len(words) == 0 {
+		return domain.Task{}, fmt.Errorf("missing task description")
+	}
+
+	task.Description = strings.Join(words, " ")
+	return task, nil
+}
+
+func parseLeadingDate(fields []string, loc *time.Location) (time.Time, bool) {
+	if len(fields) == 0 {
+		return time.Time{}, false
+	}
+
+	date, err := time.ParseInLocation(importDateLayout, fields[0], loc)
+	return date, err == nil
+}
+
+// parseTodoTxtPriority maps todo.txt priorities onto task priorities:
+// (A) is high, (B) is medium and every lower letter is low.
+func parseTodoTxtPriority(field string) (domain.Priority, bool) {
+	if len(field) != 3 || field[0] != '(' || field[2] != ')' || field[1] < 'A' || field[1] > 'Z' {
+		return domain.NoPriority, false
+	}
+
+	switch field[1] {
+	case 'A':
+		return domain.HighPriority, true
+	case 'B':
+		return domain.MediumPriority, true
+	default:
+		return domain.LowPriority, true
+	}
+}
+
+type taskwarriorTask struct {
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	Entry       string `json:"entry"`
+	Modified    string `json:"modified"`
+	Start       string `json:"start"`
+	End         string `json:"end"`
+	Due         string `json:"due"`
+	Priority    string `json:"priority"`
+}
+
+// parseTaskwarrior reads the output of "task export", which is either a JSON array
+// or (in older versions) one JSON object per line.
+func parseTaskwarrior(r io.Reader) ([]domain.Task, []importIssue, error) {
+	data, err := io.ReadAll(r)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		parsed []domain.Task
+		issues []importIssue
+	)
+
+	addEntry := func(line int, raw json.RawMessage) {
+		task, err := parseTaskwarriorEntry(raw)
+
+		if err != nil {
+			issues = append(issues, importIssue{line: line, reason: err.Error()})
+		} else {
+			parsed = append(parsed, task)
+		}
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+
+		if _, err := decoder.Token(); err != nil {
+			return nil, nil, newInvalidInputError("invalid taskwarrior export: %s", err.Error())
+		}
+
+		for decoder.More() {
+			line := lineAtOffset(data, int(decoder.InputOffset()))
+			var raw json.RawMessage
+
+			if err := decoder.Decode(&raw); err != nil {
+				return nil, nil, newInvalidInputError("invalid taskwarrior export at line %d: %s", line, err.Error())
+			}
+
+			addEntry(line, raw)
+		}
+
+		return parsed, issues, nil
+	}
+
+	for i, text := range strings.Split(string(data), "\n") {
+		text = strings.TrimSpace(text)
+
+		if text == "" {
+			continue
+		}
+
+		addEntry(i+1, json.RawMessage(strings.TrimSuffix(text, ",")))
+	}
+
+	return parsed, issues, nil
+}
+
+func parseTaskwarriorEntry(raw json.RawMessage) (domain.Task, error) {
+	var entry taskwarriorTask
+
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return domain.Task{}, fmt.Errorf("invalid task entry")
+	}
+
+	if strings.TrimSpace(entry.Description) == "" {
+		return domain.Task{}, fmt.Errorf("missing task description")
+	}
+
+	task := domain.Task{Description: entry.Description}
+
+	switch entry.Status {
+	case "pending", "waiting", "":
+		task.CurrentStatus = domain.Todo
+
+		if entry.Start != "" {
+			task.CurrentStatus = domain.InProgress
+		}
+	case "completed":
+		task.CurrentStatus = domain.Done
+	case "deleted":
+		return domain.Task{}, fmt.Errorf("deleted task is not imported")
+	case "recurring":
+		return domain.Task{}, fmt.Errorf("recurring template is not imported")
+	default:
+		return domain.Task{}, fmt.Errorf("unknown status %q", entry.Status)
+	}
+
+	switch entry.Priority {
+	case "H":
+		task.Priority = domain.HighPriority
+	case "M":
+		task.Priority = domain.MediumPriority
+	case "L":
+		task.Priority = domain.LowPriority
+	case "":
+	default:
+		return domain.Task{}, fmt.Errorf("unknown priority %q", entry.Priority)
+	}
+
+	dates := []struct {
+		name   string
+		value  string
+		target *time.Time
+	}{
+		{"entry", entry.Entry, &task.CreatedAt},
+		{"modified", entry.Modified, &task.UpdatedAt},
+		{"end", entry.End, &task.CompletedAt},
+		{"due", entry.Due, &task.DueAt},
+	}
+
+	for _, date := range dates {
+		if date.value == "" {
+			continue
+		}
+
+		parsedDate, err := time.Parse(taskwarriorLayout, date.value)
+
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("invalid %s date %q", date.name, date.value)
+		}
+
+		*date.target = parsedDate
+	}
+
+	return task, nil
+}
+
+// parseCsv reads a CSV file with a header row. Only the description column is required,
+// the status, priority, due, created and completed columns are optional.
+func parseCsv(r io.Reader, loc *time.Location) ([]domain.Task, []importIssue, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+
+	if err == io.EOF {
+		return nil, nil, nil
+	}
+
+	if err != nil {
+		return nil, nil, newInvalidInputError("invalid csv header: %s", err.Error())
+	}
+
+	columns := make(map[string]int)
+
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	if _, ok := columns["description"]; !ok {
+		return nil, nil, newInvalidInputError("csv header has no description column")
+	}
+
+	var (
+		parsed []domain.Task
+		issues []importIssue
+	)
+
+	for {
+		record, err := reader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			issues = append(issues, importIssue{line: parseErr.StartLine, reason: parseErr.Err.Error()})
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		task, err := parseCsvRecord(record, columns, loc)
+
+		if err != nil {
+			issues = append(issues, importIssue{line: line, reason: err.Error()})
+			continue
+		}
+
+		parsed = append(parsed, task)
+	}
+
+	return parsed, issues, nil
+}
+
+func parseCsvRecord(record []string, columns map[string]int, loc *time.Location) (domain.Task, error) {
+	get := func(column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+
+		return ""
+	}
+
+	task := domain.Task{Description: get("description")}
+
+	if task.Description == "" {
+		return domain.Task{}, fmt.Errorf("missing task description")
+	}
+
+	if status := get("status"); status != "" {
+		parsedStatus, err := ParseStatusString(status)
+
+		if err != nil {
+			return domain.Task{}, err
+		}
+
+		task.CurrentStatus = parsedStatus
+	}
+
+	priority, err := parseImportPriority(get("priority"))
+
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	task.Priority = priority
+
+	dates := []struct {
+		name   string
+		target *time.Time
+	}{
+		{"due", &task.DueAt},
+		{"created", &task.CreatedAt},
+		{"completed", &task.CompletedAt},
+	}
+
+	for _, date := range dates {
+		value := get(date.name)
+
+		if value == "" {
+			continue
+		}
+
+		parsedDate, err := parseImportDate(value, loc)
+
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("invalid %s date %q", date.name, value)
+		}
+
+		*date.target = parsedDate
+	}
+
+	return task, nil
+}
+
+// parseImportPriority accepts priority names as well as the H/M/L shorthand.
+func parseImportPriority(priorityStr string) (domain.Priority, error) {
+	switch strings.ToUpper(priorityStr) {
+	case "H":
+		return domain.HighPriority, nil
+	case "M":
+		return domain.MediumPriority, nil
+	case "L":
+		return domain.LowPriority, nil
+	default:
+		return ParsePriorityString(priorityStr)
+	}
+}
+
+func parseImportDate(value string, loc *time.Location) (time.Time, error) {
+	if date, err := time.Parse(time.RFC3339, value); err == nil {
+		return date, nil
+	}
+
+	if date, err := time.ParseInLocation(importDateTimeLayout, value, loc); err == nil {
+		return date, nil
+	}
+
+	return time.ParseInLocation(importDateLayout, value, loc)
+}
+
+// lineAtOffset returns the 1-based line of the first value starting at or after offset.
+func lineAtOffset(data []byte, offset int) int {
+	for offset < len(data) && strings.ContainsRune(" \t\r\n,", rune(data[offset])) {
+		offset++
+	}
+
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+func renderImportReport(parsed []domain.Task, issues []importIssue, dryRun bool) string {
+	var builder strings.Builder
+
+	if dryRun {
+		builder.WriteString(fmt.Sprintf("Dry run: %d task(s) would be imported.\n", len(parsed)))
+	} else {
+		builder.WriteString(fmt.Sprintf("Imported %d task(s).\n", len(parsed)))
+	}
+
+	if dryRun && len(parsed) > 0 {
+		builder.WriteString(fmt.Sprintf("%-3s %-20s %-12s %-8s %-10s %-16s\n",
+			"ID", "Description", "Status", "Priority", "Due", "Created At"))
+
+		for _, task := range parsed {
+			due := "-"
+			if !task.DueAt.IsZero() {
+				due = task.DueAt.Format(importDateLayout)
+			}
+
+			builder.WriteString(fmt.Sprintf("%-3s %-20s %-12s %-8s %-10s %-16s\n",
+				"-",
+				task.Description,
+				task.CurrentStatus.String(),
+				task.Priority.String(),
+				due,
+				task.CreatedAt.Format("2006-01-02 15:04"),
+			))
+		}
+	}
+
+	if len(issues) > 0 {
+		builder.WriteString(fmt.Sprintf("Skipped %d line(s):\n", len(issues)))
+
+		for _, issue := range issues {
+			builder.WriteString(fmt.Sprintf("  line %d: %s\n", issue.line, issue.reason))
+		}
+	}
+
+	return builder.String()
+}
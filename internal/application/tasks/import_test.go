@@ -0,0 +1,274 @@
+package tasks
+
+import (
+	"fmt"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+var importNow = time.Date(2025, 9, 30, 12, 0, 0, 0, time.UTC)
+
+func TestParseImport(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		format         string
+		input          string
+		expectedTasks  []domain.Task
+		expectedIssues []importIssue
+		expectedErr    error
+	}
+
+	tests := []testCase{
+		{
+			name:   "todo.txt",
+			format: TodoTxtFormat,
+			input: "(A) 2025-09-01 Call mom +family due:2025-09-05\n" +
+				"\n" +
+				"x 2025-09-03 2025-09-02 Pay rent pri:B\n" +
+				"(D) Water plants\n" +
+				"Broken due date due:tomorrow\n" +
+				"x 2025-09-03\n",
+			expectedTasks: []domain.Task{
+				{Description: "Call mom +family", CurrentStatus: domain.Todo, Priority: domain.HighPriority,
+					DueAt:     time.Date(2025, 9, 5, 0, 0, 0, 0, time.UTC),
+					CreatedAt: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)},
+				{Description: "Pay rent", CurrentStatus: domain.Done, Priority: domain.MediumPriority,
+					CreatedAt: time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 3, 0, 0, 0, 0, time.UTC),
+					CompletedAt: time.Date(2025, 9, 3, 0, 0, 0, 0, time.UTC)},
+				{Description: "Water plants", CurrentStatus: domain.Todo, Priority: domain.LowPriority,
+					CreatedAt: importNow, UpdatedAt: importNow},
+			},
+			expectedIssues: []importIssue{
+				{line: 5, reason: `invalid due date "tomorrow"`},
+				{line: 6, reason: "missing task description"},
+			},
+		},
+		{
+			name:   "Taskwarrior array",
+			format: TaskwarriorFormat,
+			input: "[\n" +
+				`{"description":"Write report","status":"pending","entry":"20250901T080000Z","modified":"20250902T080000Z","priority":"H","due":"20250910T170000Z"},` + "\n" +
+				`{"description":"Review PR","status":"pending","start":"20250903T090000Z","entry":"20250903T080000Z"},` + "\n" +
+				`{"description":"Old","status":"deleted"},` + "\n" +
+				`{"description":"Deploy","status":"completed","entry":"20250901T080000Z","end":"20250904T080000Z","priority":"L"},` + "\n" +
+				`{"description":"Bad date","status":"pending","due":"tomorrow"}` + "\n" +
+				"]\n",
+			expectedTasks: []domain.Task{
+				{Description: "Write report", CurrentStatus: domain.Todo, Priority: domain.HighPriority,
+					DueAt:     time.Date(2025, 9, 10, 17, 0, 0, 0, time.UTC),
+					CreatedAt: time.Date(2025, 9, 1, 8, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 2, 8, 0, 0, 0, time.UTC)},
+				{Description: "Review PR", CurrentStatus: domain.InProgress,
+					CreatedAt: time.Date(2025, 9, 3, 8, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 3, 8, 0, 0, 0, time.UTC)},
+				{Description: "Deploy", CurrentStatus: domain.Done, Priority: domain.LowPriority,
+					CreatedAt: time.Date(2025, 9, 1, 8, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 4, 8, 0, 0, 0, time.UTC),
+					CompletedAt: time.Date(2025, 9, 4, 8, 0, 0, 0, time.UTC)},
+			},
+			expectedIssues: []importIssue{
+				{line: 4, reason: "deleted task is not imported"},
+				{line: 6, reason: `invalid due date "tomorrow"`},
+			},
+		},
+		{
+			name:   "Taskwarrior object per line",
+			format: TaskwarriorFormat,
+			input: `{"description":"One","status":"pending","entry":"20250901T080000Z"}` + "\n" +
+				`{"status":"pending"}` + "\n",
+			expectedTasks: []domain.Task{
+				{Description: "One", CurrentStatus: domain.Todo,
+					CreatedAt: time.Date(2025, 9, 1, 8, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 1, 8, 0, 0, 0, time.UTC)},
+			},
+			expectedIssues: []importIssue{
+				{line: 2, reason: "missing task description"},
+			},
+		},
+		{
+			name:        "Taskwarrior broken json",
+			format:      TaskwarriorFormat,
+			input:       "[\n{\"description\": }\n]",
+			expectedErr: fmt.Errorf("invalid taskwarrior export at line 2"),
+		},
+		{
+			name:   "CSV",
+			format: CsvFormat,
+			input: "Description,Status,Priority,Due,Created,Completed\n" +
+				"Buy milk,todo,high,2025-10-01,2025-09-01 09:30,\n" +
+				"\"Ship, release\",done,M,,2025-09-01,2025-09-02T10:00:00Z\n" +
+				",todo,,,,\n" +
+				"Plan trip,someday,,,,\n" +
+				"Short row\n",
+			expectedTasks: []domain.Task{
+				{Description: "Buy milk", CurrentStatus: domain.Todo, Priority: domain.HighPriority,
+					DueAt:     time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt: time.Date(2025, 9, 1, 9, 30, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 1, 9, 30, 0, 0, time.UTC)},
+				{Description: "Ship, release", CurrentStatus: domain.Done, Priority: domain.MediumPriority,
+					CreatedAt: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 2, 10, 0, 0, 0, time.UTC),
+					CompletedAt: time.Date(2025, 9, 2, 10, 0, 0, 0, time.UTC)},
+				{Description: "Short row", CurrentStatus: domain.Todo, CreatedAt: importNow, UpdatedAt: importNow},
+			},
+			expectedIssues: []importIssue{
+				{line: 4, reason: "missing task description"},
+				{line: 5, reason: "invalid status string: someday"},
+			},
+		},
+		{
+			name:        "CSV without description column",
+			format:      CsvFormat,
+			input:       "title,status\nBuy milk,todo\n",
+			expectedErr: fmt.Errorf("csv header has no description column"),
+		},
+		{
+			name:        "Unknown format",
+			format:      "xml",
+			expectedErr: fmt.Errorf("invalid import format: xml"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, issues, err := parseImport(tt.format, strings.NewReader(tt.input), importNow)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTasks, parsed)
+				assert.Equal(t, tt.expectedIssues, issues)
+			}
+		})
+	}
+}
+
+func TestImportTasks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existing := domain.Task{Id: 1, Description: "Existing"}
+	input := "Buy milk\n(A) Call mom\nx\n"
+
+	type testCase struct {
+		name          string
+		dryRun        bool
+		testStorageFn func(t *testing.T) (domain.TaskStorage, domain.EventPublisher)
+		expectedOut   string
+		expectedErr   error
+	}
+
+	tests := []testCase{
+		{
+			name: "Successful Import",
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher) {
+				t.Helper()
+
+				milk := domain.Task{Id: 5, Uuid: "uuid", Description: "Buy milk", CreatedAt: importNow, UpdatedAt: importNow,
+					CreatedBy: "alice", Assignee: "alice"}
+				mom := domain.Task{Id: 6, Uuid: "uuid", Description: "Call mom", Priority: domain.HighPriority, CreatedAt: importNow, UpdatedAt: importNow,
+					CreatedBy: "alice", Assignee: "alice"}
+				milkEvent := domain.Event{Type: domain.TaskCreated, Task: milk, OccurredAt: importNow}
+				momEvent := domain.Event{Type: domain.TaskCreated, Task: mom, OccurredAt: importNow}
+
+				storage := mocks.NewMockTaskStorage(ctrl)
+				publisher := mocks.NewMockEventPublisher(ctrl)
+				firstCall := storage.EXPECT().Load().Return([]domain.Task{existing}, nil).Times(1)
+				secondCall := storage.EXPECT().NextId().Return(5, nil).Times(1).After(firstCall)
+				publisher.EXPECT().Before(milkEvent).Return(nil).Times(1)
+				publisher.EXPECT().Before(momEvent).Return(nil).Times(1)
+				fourthCall := storage.EXPECT().Save(gomock.Eq([]domain.Task{existing, milk, mom})).Times(1).After(secondCall)
+				publisher.EXPECT().Publish(milkEvent).Times(1).After(fourthCall)
+				publisher.EXPECT().Publish(momEvent).Times(1).After(fourthCall)
+
+				return storage, publisher
+			},
+			expectedOut: "Imported 2 task(s).\nSkipped 1 line(s):\n  line 3: missing task description\n",
+		},
+		{
+			name:   "Dry run does not touch storage",
+			dryRun: true,
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher) {
+				t.Helper()
+
+				return mocks.NewMockTaskStorage(ctrl), mocks.NewMockEventPublisher(ctrl)
+			},
+			expectedOut: "Dry run: 2 task(s) would be imported.\n" +
+				fmt.Sprintf("%-3s %-20s %-12s %-8s %-10s %-16s\n", "ID", "Description", "Status", "Priority", "Due", "Created At") +
+				fmt.Sprintf("%-3s %-20s %-12s %-8s %-10s %-16s\n", "-", "Buy milk", "todo", "none", "-", "2025-09-30 12:00") +
+				fmt.Sprintf("%-3s %-20s %-12s %-8s %-10s %-16s\n", "-", "Call mom", "todo", "high", "-", "2025-09-30 12:00") +
+				"Skipped 1 line(s):\n  line 3: missing task description\n",
+		},
+		{
+			name: "Storage NextId Error",
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher) {
+				t.Helper()
+
+				storage := mocks.NewMockTaskStorage(ctrl)
+				firstCall := storage.EXPECT().Load().Return([]domain.Task{existing}, nil).Times(1)
+				storage.EXPECT().NextId().Return(0, assert.AnError).Times(1).After(firstCall)
+
+				return storage, mocks.NewMockEventPublisher(ctrl)
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name: "Rejected by a hook",
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher) {
+				t.Helper()
+
+				storage := mocks.NewMockTaskStorage(ctrl)
+				publisher := mocks.NewMockEventPublisher(ctrl)
+				firstCall := storage.EXPECT().Load().Return([]domain.Task{existing}, nil).Times(1)
+				storage.EXPECT().NextId().Return(5, nil).Times(1).After(firstCall)
+				publisher.EXPECT().Before(gomock.Any()).Return(assert.AnError).Times(1)
+
+				return storage, publisher
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name: "Storage Save Error",
+			testStorageFn: func(t *testing.T) (domain.TaskStorage, domain.EventPublisher) {
+				t.Helper()
+
+				storage := mocks.NewMockTaskStorage(ctrl)
+				firstCall := storage.EXPECT().Load().Return([]domain.Task{existing}, nil).Times(1)
+				secondCall := storage.EXPECT().NextId().Return(5, nil).Times(1).After(firstCall)
+				storage.EXPECT().Save(gomock.Any()).Return(assert.AnError).Times(1).After(secondCall)
+
+				publisher := mocks.NewMockEventPublisher(ctrl)
+				publisher.EXPECT().Before(gomock.Any()).Return(nil).Times(2)
+
+				return storage, publisher
+			},
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage, publisher := tt.testStorageFn(t)
+			out, err := importTasks(storage, publisher, TodoTxtFormat, strings.NewReader(input), tt.dryRun, "alice",
+				func() time.Time { return importNow },
+				func() string { return "uuid" })
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOut, out)
+			}
+		})
+	}
+}
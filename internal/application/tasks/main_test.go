@@ -0,0 +1,14 @@
+package tasks
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+// TestMain shows dates in UTC, the zone of the fixtures, so that the rendered output
+// does not depend on the zone of the machine running the tests.
+func TestMain(m *testing.M) {
+	SetTimeZone(time.UTC)
+	os.Exit(m.Run())
+}
@@ -0,0 +1,425 @@
+package tasks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"os"
+	"sort"
+)
+
+const renumberedField = "id"
+
+// MergeConflict describes a change made on both sides that could not be combined cleanly.
+// The merge still produces a result, the conflict tells which side was picked.
+type MergeConflict struct {
+	TaskId int
+	Field  string
+	Reason string
+}
+
+// IsRenumbering reports whether the conflict only records a task that was given a new ID
+// because its ID was already taken. Such conflicts need no attention.
+func (c MergeConflict) IsRenumbering() bool {
+	return c.Field == renumberedField
+}
+
+func (c MergeConflict) String() string {
+	if c.Field == "" {
+		return fmt.Sprintf("task %d: %s", c.TaskId, c.Reason)
+	}
+
+	return fmt.Sprintf("task %d: %s: %s", c.TaskId, c.Field, c.Reason)
+}
+
+// taskField is a group of task fields that is merged as a whole.
+type taskField struct {
+	name  string
+	equal func(a, b domain.Task) bool
+	copy  func(to *domain.Task, from domain.Task)
+	// combine, when set, joins changes made on both sides instead of keeping the newer one.
+	combine func(to *domain.Task, base, from domain.Task)
+}
+
+var mergedTaskFields = []taskField{
+	{
+		name:  "id",
+		equal: func(a, b domain.Task) bool { return a.Id == b.Id },
+		copy:  func(to *domain.Task, from domain.Task) { to.Id = from.Id },
+	},
+	{
+		name:  "description",
+		equal: func(a, b domain.Task) bool { return a.Description == b.Description },
+		copy:  func(to *domain.Task, from domain.Task) { to.Description = from.Description },
+	},
+	{
+		name: "status",
+		equal: func(a, b domain.Task) bool {
+			return a.CurrentStatus == b.CurrentStatus && a.CompletedAt.Equal(b.CompletedAt)
+		},
+		copy: func(to *domain.Task, from domain.Task) {
+			to.CurrentStatus = from.CurrentStatus
+			to.CompletedAt = from.CompletedAt
+		},
+	},
+	{
+		name:  "priority",
+		equal: func(a, b domain.Task) bool { return a.Priority == b.Priority },
+		copy:  func(to *domain.Task, from domain.Task) { to.Priority = from.Priority },
+	},
+	{
+		name:  "due date",
+		equal: func(a, b domain.Task) bool { return a.DueAt.Equal(b.DueAt) },
+		copy:  func(to *domain.Task, from domain.Task) { to.DueAt = from.DueAt },
+	},
+	{
+		name:  "reminder",
+		equal: func(a, b domain.Task) bool { return a.RemindAt.Equal(b.RemindAt) },
+		copy:  func(to *domain.Task, from domain.Task) { to.RemindAt = from.RemindAt },
+	},
+	{
+		name:  "assignee",
+		equal: func(a, b domain.Task) bool { return a.Assignee == b.Assignee },
+		copy:  func(to *domain.Task, from domain.Task) { to.Assignee = from.Assignee },
+	},
+	{
+		name:  "time entries",
+		equal: func(a, b domain.Task) bool { return sameTimeEntries(a.TimeEntries, b.TimeEntries) },
+		copy:  func(to *domain.Task, from domain.Task) { to.TimeEntries = from.TimeEntries },
+		// Time entries are only ever added, so the work logged on both sides is kept.
+		combine: func(to *domain.Task, _, from domain.Task) {
+			to.TimeEntries = unionTimeEntries(to.TimeEntries, from.TimeEntries)
+		},
+	},
+	{
+		name:  "comments",
+		equal: func(a, b domain.Task) bool { return sameComments(a.Comments, b.Comments) },
+		copy:  func(to *domain.Task, from domain.Task) { to.Comments = from.Comments },
+		combine: func(to *domain.Task, base, from domain.Task) {
+			to.Comments = mergeComments(base.Comments, to.Comments, from.Comments)
+		},
+	},
+}
+
+// MergeTasks combines two versions of a task list that both started from base.
+//
+// Tasks are matched by UUID (by ID for tasks without one). Fields changed on one side only are
+// taken from that side. When both sides changed a field differently, the side with the later
+// UpdatedAt wins and a conflict is reported. A task deleted on one side stays deleted unless the
+// other side changed it. Tasks of the other side whose ID is already taken get a fresh ID.
+func MergeTasks(base, ours, theirs []domain.Task) ([]domain.Task, []MergeConflict) {
+	baseByKey := indexTasks(base)
+	oursByKey := indexTasks(ours)
+	theirsByKey := indexTasks(theirs)
+
+	var merged []domain.Task
+	var conflicts []MergeConflict
+
+	for _, task := range ours {
+		key := taskKey(task)
+		baseTask, inBase := baseByKey[key]
+		theirTask, inTheirs := theirsByKey[key]
+
+		switch {
+		case inTheirs:
+			result, fieldConflicts := mergeTask(baseTask, task, theirTask)
+			merged = append(merged, result)
+			conflicts = append(conflicts, fieldConflicts...)
+		case !inBase:
+			merged = append(merged, task)
+		case sameTask(baseTask, task):
+			continue
+		default:
+			merged = append(merged, task)
+			conflicts = append(conflicts, MergeConflict{TaskId: task.Id, Reason: "changed here but deleted on the other side, kept"})
+		}
+	}
+
+	for _, task := range theirs {
+		key := taskKey(task)
+
+		if _, inOurs := oursByKey[key]; inOurs {
+			continue
+		}
+
+		baseTask, inBase := baseByKey[key]
+
+		switch {
+		case !inBase:
+			merged = append(merged, task)
+		case sameTask(baseTask, task):
+			continue
+		default:
+			merged = append(merged, task)
+			conflicts = append(conflicts, MergeConflict{TaskId: task.Id, Reason: "deleted here but changed on the other side, kept"})
+		}
+	}
+
+	nextId := maxTaskId(base, ours, theirs, merged) + 1
+	taken := make(map[int]bool, len(merged))
+
+	for i := range merged {
+		if taken[merged[i].Id] {
+			conflicts = append(conflicts, MergeConflict{TaskId: merged[i].Id, Field: renumberedField,
+				Reason: fmt.Sprintf("already taken, renumbered to %d", nextId)})
+			merged[i].Id = nextId
+			nextId++
+		}
+
+		taken[merged[i].Id] = true
+	}
+
+	if merged == nil {
+		merged = make([]domain.Task, 0)
+	}
+
+	return merged, conflicts
+}
+
+// MergeTaskFiles merges the task lists in the base, ours and theirs JSON files and writes the result
+// over the ours file, the way git expects from a merge driver. A missing or empty file is an empty list.
+func MergeTaskFiles(basePath, oursPath, theirsPath string) ([]MergeConflict, error) {
+	base, err := readTaskFile(basePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ours, err := readTaskFile(oursPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	theirs, err := readTaskFile(theirsPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	merged, conflicts := MergeTasks(base, ours, theirs)
+	content, err := json.MarshalIndent(inUTC(merged), "", "  ")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return conflicts, os.WriteFile(oursPath, append(content, '\n'), 0644)
+}
+
+func readTaskFile(path string) ([]domain.Task, error) {
+	content, err := os.ReadFile(path)
+
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []domain.Task
+
+	if len(bytes.TrimSpace(content)) == 0 {
+		return tasks, nil
+	}
+
+	if err := json.Unmarshal(content, &tasks); err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", path, err)
+	}
+
+	return tasks, nil
+}
+
+// mergeTask merges the fields of one task. A zero base means the task was added on both sides.
+func mergeTask(base, ours, theirs domain.Task) (domain.Task, []MergeConflict) {
+	result := ours
+	var conflicts []MergeConflict
+
+	theirsIsNewer := theirs.UpdatedAt.After(ours.UpdatedAt)
+
+	for _, field := range mergedTaskFields {
+		oursChanged := !field.equal(base, ours)
+		theirsChanged := !field.equal(base, theirs)
+
+		switch {
+		case !theirsChanged || field.equal(ours, theirs):
+			continue
+		case !oursChanged:
+			field.copy(&result, theirs)
+		case field.combine != nil:
+			field.combine(&result, base, theirs)
+		default:
+			side := "this side"
+			if theirsIsNewer {
+				field.copy(&result, theirs)
+				side = "the other side"
+			}
+
+			conflicts = append(conflicts, MergeConflict{TaskId: ours.Id, Field: field.name,
+				Reason: fmt.Sprintf("changed on both sides, kept the newer change from %s", side)})
+		}
+	}
+
+	if theirsIsNewer {
+		result.UpdatedAt = theirs.UpdatedAt
+	}
+
+	if result.Uuid == "" {
+		result.Uuid = theirs.Uuid
+	}
+
+	return result, conflicts
+}
+
+func indexTasks(tasks []domain.Task) map[string]domain.Task {
+	index := make(map[string]domain.Task, len(tasks))
+
+	for _, task := range tasks {
+		index[taskKey(task)] = task
+	}
+
+	return index
+}
+
+// taskKey is the identity of a task across copies of the task list.
+func taskKey(task domain.Task) string {
+	if task.Uuid != "" {
+		return task.Uuid
+	}
+
+	return fmt.Sprintf("id:%d", task.Id)
+}
+
+func sameTask(a, b domain.Task) bool {
+	for _, field := range mergedTaskFields {
+		if !field.equal(a, b) {
+			return false
+		}
+	}
+
+	return a.UpdatedAt.Equal(b.UpdatedAt)
+}
+
+func sameTimeEntries(a, b []domain.TimeEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].StartedAt.Equal(b[i].StartedAt) || !a[i].EndedAt.Equal(b[i].EndedAt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// unionTimeEntries returns the entries of both lists ordered by start, an entry in both lists only once.
+func unionTimeEntries(ours, theirs []domain.TimeEntry) []domain.TimeEntry {
+	union := append([]domain.TimeEntry(nil), ours...)
+
+	for _, entry := range theirs {
+		found := false
+
+		for _, existing := range ours {
+			if existing.StartedAt.Equal(entry.StartedAt) && existing.EndedAt.Equal(entry.EndedAt) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			union = append(union, entry)
+		}
+	}
+
+	sort.SliceStable(union, func(i, j int) bool {
+		return union[i].StartedAt.Before(union[j].StartedAt)
+	})
+
+	return union
+}
+
+func sameComments(a, b []domain.Comment) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Id != b[i].Id || a[i].Author != b[i].Author || a[i].Body != b[i].Body ||
+			!a[i].CreatedAt.Equal(b[i].CreatedAt) || !a[i].UpdatedAt.Equal(b[i].UpdatedAt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeComments keeps the comments added on either side and drops those deleted on either side.
+// A comment edited on both sides keeps the later edit. Comments are matched by author and creation time,
+// as both sides number their new comments alike, and a comment whose ID is taken gets a new one.
+func mergeComments(base, ours, theirs []domain.Comment) []domain.Comment {
+	key := func(comment domain.Comment) string {
+		return fmt.Sprintf("%s@%d", comment.Author, comment.CreatedAt.UnixNano())
+	}
+
+	inBase := make(map[string]bool, len(base))
+	for _, comment := range base {
+		inBase[key(comment)] = true
+	}
+
+	theirsByKey := make(map[string]domain.Comment, len(theirs))
+	for _, comment := range theirs {
+		theirsByKey[key(comment)] = comment
+	}
+
+	var merged []domain.Comment
+	inOurs := make(map[string]bool, len(ours))
+
+	for _, comment := range ours {
+		inOurs[key(comment)] = true
+		theirComment, found := theirsByKey[key(comment)]
+
+		switch {
+		case found && theirComment.UpdatedAt.After(comment.UpdatedAt):
+			merged = append(merged, theirComment)
+		case found || !inBase[key(comment)]:
+			merged = append(merged, comment)
+		}
+	}
+
+	for _, comment := range theirs {
+		if !inOurs[key(comment)] && !inBase[key(comment)] {
+			merged = append(merged, comment)
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].CreatedAt.Before(merged[j].CreatedAt)
+	})
+
+	taken := make(map[int]bool, len(merged))
+	for i := range merged {
+		if taken[merged[i].Id] {
+			merged[i].Id = nextCommentId(merged)
+		}
+
+		taken[merged[i].Id] = true
+	}
+
+	return merged
+}
+
+func maxTaskId(lists ...[]domain.Task) int {
+	maxId := 0
+
+	for _, tasks := range lists {
+		for _, task := range tasks {
+			maxId = max(maxId, task.Id)
+		}
+	}
+
+	return maxId
+}
@@ -0,0 +1,271 @@
+package tasks
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMergeTasks(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour int) time.Time {
+		return time.Date(2025, 9, 1, hour, 0, 0, 0, time.UTC)
+	}
+
+	report := domain.Task{Id: 1, Uuid: "uuid-1", Description: "Write report", CurrentStatus: domain.Todo, CreatedAt: at(8), UpdatedAt: at(8)}
+	trip := domain.Task{Id: 2, Uuid: "uuid-2", Description: "Plan trip", CurrentStatus: domain.Todo, CreatedAt: at(8), UpdatedAt: at(8)}
+
+	change := func(task domain.Task, hour int, fn func(task *domain.Task)) domain.Task {
+		fn(&task)
+		task.UpdatedAt = at(hour)
+		return task
+	}
+
+	markDone := func(task *domain.Task) {
+		task.CurrentStatus = domain.Done
+		task.CompletedAt = at(10)
+	}
+
+	type testCase struct {
+		name              string
+		base              []domain.Task
+		ours              []domain.Task
+		theirs            []domain.Task
+		expected          []domain.Task
+		expectedConflicts []string
+	}
+
+	tests := []testCase{
+		{
+			name:     "No changes",
+			base:     []domain.Task{report, trip},
+			ours:     []domain.Task{report, trip},
+			theirs:   []domain.Task{report, trip},
+			expected: []domain.Task{report, trip},
+		},
+		{
+			name:     "Different fields changed on each side",
+			base:     []domain.Task{report},
+			ours:     []domain.Task{change(report, 9, func(task *domain.Task) { task.Description = "Write the report" })},
+			theirs:   []domain.Task{change(report, 10, markDone)},
+			expected: []domain.Task{{Id: 1, Uuid: "uuid-1", Description: "Write the report", CurrentStatus: domain.Done, CreatedAt: at(8), UpdatedAt: at(10), CompletedAt: at(10)}},
+		},
+		{
+			name:   "Same field changed on both sides takes the newer change",
+			base:   []domain.Task{report},
+			ours:   []domain.Task{change(report, 11, func(task *domain.Task) { task.Description = "Ours" })},
+			theirs: []domain.Task{change(report, 12, func(task *domain.Task) { task.Description = "Theirs" })},
+			expected: []domain.Task{
+				change(report, 12, func(task *domain.Task) { task.Description = "Theirs" }),
+			},
+			expectedConflicts: []string{"task 1: description: changed on both sides, kept the newer change from the other side"},
+		},
+		{
+			name: "Time entries logged on both sides are combined",
+			base: []domain.Task{report},
+			ours: []domain.Task{change(report, 11, func(task *domain.Task) {
+				task.TimeEntries = []domain.TimeEntry{{StartedAt: at(10), EndedAt: at(11)}}
+			})},
+			theirs: []domain.Task{change(report, 10, func(task *domain.Task) {
+				task.TimeEntries = []domain.TimeEntry{{StartedAt: at(9), EndedAt: at(10)}}
+			})},
+			expected: []domain.Task{change(report, 11, func(task *domain.Task) {
+				task.TimeEntries = []domain.TimeEntry{{StartedAt: at(9), EndedAt: at(10)}, {StartedAt: at(10), EndedAt: at(11)}}
+			})},
+		},
+		{
+			name: "Comments added, edited and deleted on both sides are combined",
+			base: []domain.Task{change(report, 9, func(task *domain.Task) {
+				task.Comments = []domain.Comment{{Id: 1, Author: "alice", Body: "Draft", CreatedAt: at(9)}, {Id: 2, Author: "bob", Body: "Typo", CreatedAt: at(9)}}
+			})},
+			ours: []domain.Task{change(report, 11, func(task *domain.Task) {
+				task.Comments = []domain.Comment{
+					{Id: 1, Author: "alice", Body: "Final draft", CreatedAt: at(9), UpdatedAt: at(11)},
+					{Id: 2, Author: "bob", Body: "Typo", CreatedAt: at(9)},
+					{Id: 3, Author: "alice", Body: "Sent", CreatedAt: at(11)},
+				}
+			})},
+			theirs: []domain.Task{change(report, 10, func(task *domain.Task) {
+				task.Comments = []domain.Comment{
+					{Id: 1, Author: "alice", Body: "Draft", CreatedAt: at(9)},
+					{Id: 3, Author: "bob", Body: "Reviewed", CreatedAt: at(10)},
+				}
+			})},
+			expected: []domain.Task{change(report, 11, func(task *domain.Task) {
+				task.Comments = []domain.Comment{
+					{Id: 1, Author: "alice", Body: "Final draft", CreatedAt: at(9), UpdatedAt: at(11)},
+					{Id: 3, Author: "bob", Body: "Reviewed", CreatedAt: at(10)},
+					{Id: 4, Author: "alice", Body: "Sent", CreatedAt: at(11)},
+				}
+			})},
+		},
+		{
+			name:     "Same change on both sides is no conflict",
+			base:     []domain.Task{report},
+			ours:     []domain.Task{change(report, 10, markDone)},
+			theirs:   []domain.Task{change(report, 10, markDone)},
+			expected: []domain.Task{change(report, 10, markDone)},
+		},
+		{
+			name:     "Deletion of an unchanged task is kept",
+			base:     []domain.Task{report, trip},
+			ours:     []domain.Task{report},
+			theirs:   []domain.Task{report, trip},
+			expected: []domain.Task{report},
+		},
+		{
+			name:     "Deletion on the other side is kept",
+			base:     []domain.Task{report, trip},
+			ours:     []domain.Task{report, trip},
+			theirs:   []domain.Task{trip},
+			expected: []domain.Task{trip},
+		},
+		{
+			name:              "Deleted task changed on the other side is kept",
+			base:              []domain.Task{report},
+			ours:              []domain.Task{},
+			theirs:            []domain.Task{change(report, 10, markDone)},
+			expected:          []domain.Task{change(report, 10, markDone)},
+			expectedConflicts: []string{"task 1: deleted here but changed on the other side, kept"},
+		},
+		{
+			name:     "Added tasks from both sides with clashing IDs",
+			base:     []domain.Task{report},
+			ours:     []domain.Task{report, trip},
+			theirs:   []domain.Task{report, {Id: 2, Uuid: "uuid-3", Description: "Call mom", CreatedAt: at(9), UpdatedAt: at(9)}},
+			expected: []domain.Task{report, trip, {Id: 3, Uuid: "uuid-3", Description: "Call mom", CreatedAt: at(9), UpdatedAt: at(9)}},
+			expectedConflicts: []string{
+				"task 2: id: already taken, renumbered to 3",
+			},
+		},
+		{
+			name:     "Renumbering from the other side is taken over",
+			base:     []domain.Task{trip},
+			ours:     []domain.Task{change(trip, 10, markDone)},
+			theirs:   []domain.Task{{Id: 1, Uuid: "uuid-3", Description: "Call mom", CreatedAt: at(9), UpdatedAt: at(9)}, change(trip, 9, func(task *domain.Task) { task.Id = 5 })},
+			expected: []domain.Task{{Id: 5, Uuid: "uuid-2", Description: "Plan trip", CurrentStatus: domain.Done, CreatedAt: at(8), UpdatedAt: at(10), CompletedAt: at(10)}, {Id: 1, Uuid: "uuid-3", Description: "Call mom", CreatedAt: at(9), UpdatedAt: at(9)}},
+		},
+		{
+			name:     "Tasks without UUID are matched by ID",
+			base:     []domain.Task{{Id: 1, Description: "Old"}},
+			ours:     []domain.Task{{Id: 1, Description: "Old", CurrentStatus: domain.Done}},
+			theirs:   []domain.Task{{Id: 1, Description: "New"}},
+			expected: []domain.Task{{Id: 1, Description: "New", CurrentStatus: domain.Done}},
+		},
+		{
+			name:     "No base",
+			base:     nil,
+			ours:     []domain.Task{report},
+			theirs:   []domain.Task{trip},
+			expected: []domain.Task{report, trip},
+		},
+		{
+			name:     "Everything deleted",
+			base:     []domain.Task{report},
+			ours:     []domain.Task{},
+			theirs:   []domain.Task{},
+			expected: []domain.Task{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			merged, conflicts := MergeTasks(tt.base, tt.ours, tt.theirs)
+
+			var conflictStrings []string
+			for _, conflict := range conflicts {
+				conflictStrings = append(conflictStrings, conflict.String())
+			}
+
+			assert.Equal(t, tt.expected, merged)
+			assert.Equal(t, tt.expectedConflicts, conflictStrings)
+		})
+	}
+}
+
+func TestMergeTaskFiles(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name              string
+		base              string
+		ours              string
+		theirs            string
+		expected          string
+		expectedConflicts []MergeConflict
+		expectedErr       string
+	}
+
+	tests := []testCase{
+		{
+			name:   "Merged result is written over ours",
+			base:   `[{"Id":1,"Uuid":"a","Description":"Old","CurrentStatus":0}]`,
+			ours:   `[{"Id":1,"Uuid":"a","Description":"Old","CurrentStatus":2}]`,
+			theirs: `[{"Id":1,"Uuid":"a","Description":"New","CurrentStatus":0},{"Id":2,"Uuid":"b","Description":"Added","CurrentStatus":0}]`,
+			expected: `[{"Id":1,"Uuid":"a","Description":"New","CurrentStatus":2,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z"},
+				{"Id":2,"Uuid":"b","Description":"Added","CurrentStatus":0,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z"}]`,
+		},
+		{
+			name:   "Empty base",
+			base:   "",
+			ours:   `[{"Id":1,"Uuid":"a","Description":"Ours","CurrentStatus":0}]`,
+			theirs: `[{"Id":1,"Uuid":"b","Description":"Theirs","CurrentStatus":0}]`,
+			expected: `[{"Id":1,"Uuid":"a","Description":"Ours","CurrentStatus":0,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z"},
+				{"Id":2,"Uuid":"b","Description":"Theirs","CurrentStatus":0,"CreatedAt":"0001-01-01T00:00:00Z","UpdatedAt":"0001-01-01T00:00:00Z"}]`,
+			expectedConflicts: []MergeConflict{{TaskId: 1, Field: "id", Reason: "already taken, renumbered to 2"}},
+		},
+		{
+			name:        "Invalid JSON",
+			base:        "[]",
+			ours:        "[]",
+			theirs:      "<<<<<<< HEAD",
+			expectedErr: "cannot parse %s: invalid character '<' looking for beginning of value",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			paths := make([]string, 3)
+
+			for i, content := range []string{tt.base, tt.ours, tt.theirs} {
+				paths[i] = filepath.Join(dir, fmt.Sprintf("file%d.json", i))
+				require.NoError(t, os.WriteFile(paths[i], []byte(content), 0644))
+			}
+
+			conflicts, err := MergeTaskFiles(paths[0], paths[1], paths[2])
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, fmt.Sprintf(tt.expectedErr, paths[2]))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedConflicts, conflicts)
+
+			merged, err := os.ReadFile(paths[1])
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(merged))
+		})
+	}
+}
+
+func TestMergeConflictIsRenumbering(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, MergeConflict{TaskId: 1, Field: "id", Reason: "already taken, renumbered to 2"}.IsRenumbering())
+	assert.False(t, MergeConflict{TaskId: 1, Field: "description"}.IsRenumbering())
+	assert.False(t, MergeConflict{TaskId: 1, Reason: "deleted here but changed on the other side, kept"}.IsRenumbering())
+}
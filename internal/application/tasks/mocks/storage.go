@@ -1,16 +1,207 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks (interfaces: TaskStorage)
+// Source: github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks (interfaces: EventPublisher,Notifier,ReminderLog,TaskArchive,TaskStorage,TemplateStore)
 
 // Package mocks is a generated GoMock package.
 package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	tasks "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockEventPublisher is a mock of EventPublisher interface.
+type MockEventPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventPublisherMockRecorder
+}
+
+// MockEventPublisherMockRecorder is the mock recorder for MockEventPublisher.
+type MockEventPublisherMockRecorder struct {
+	mock *MockEventPublisher
+}
+
+// NewMockEventPublisher creates a new mock instance.
+func NewMockEventPublisher(ctrl *gomock.Controller) *MockEventPublisher {
+	mock := &MockEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockEventPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventPublisher) EXPECT() *MockEventPublisherMockRecorder {
+	return m.recorder
+}
+
+// Before mocks base method.
+func (m *MockEventPublisher) Before(arg0 tasks.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Before", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Before indicates an expected call of Before.
+func (mr *MockEventPublisherMockRecorder) Before(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Before", reflect.TypeOf((*MockEventPublisher)(nil).Before), arg0)
+}
+
+// Publish mocks base method.
+func (m *MockEventPublisher) Publish(arg0 tasks.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", arg0)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventPublisherMockRecorder) Publish(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventPublisher)(nil).Publish), arg0)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(arg0 tasks.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), arg0)
+}
+
+// MockReminderLog is a mock of ReminderLog interface.
+type MockReminderLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderLogMockRecorder
+}
+
+// MockReminderLogMockRecorder is the mock recorder for MockReminderLog.
+type MockReminderLogMockRecorder struct {
+	mock *MockReminderLog
+}
+
+// NewMockReminderLog creates a new mock instance.
+func NewMockReminderLog(ctrl *gomock.Controller) *MockReminderLog {
+	mock := &MockReminderLog{ctrl: ctrl}
+	mock.recorder = &MockReminderLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderLog) EXPECT() *MockReminderLogMockRecorder {
+	return m.recorder
+}
+
+// Load mocks base method.
+func (m *MockReminderLog) Load() (map[string]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].(map[string]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockReminderLogMockRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockReminderLog)(nil).Load))
+}
+
+// Save mocks base method.
+func (m *MockReminderLog) Save(arg0 map[string]time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockReminderLogMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockReminderLog)(nil).Save), arg0)
+}
+
+// MockTaskArchive is a mock of TaskArchive interface.
+type MockTaskArchive struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskArchiveMockRecorder
+}
+
+// MockTaskArchiveMockRecorder is the mock recorder for MockTaskArchive.
+type MockTaskArchiveMockRecorder struct {
+	mock *MockTaskArchive
+}
+
+// NewMockTaskArchive creates a new mock instance.
+func NewMockTaskArchive(ctrl *gomock.Controller) *MockTaskArchive {
+	mock := &MockTaskArchive{ctrl: ctrl}
+	mock.recorder = &MockTaskArchiveMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskArchive) EXPECT() *MockTaskArchiveMockRecorder {
+	return m.recorder
+}
+
+// Load mocks base method.
+func (m *MockTaskArchive) Load() ([]tasks.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load")
+	ret0, _ := ret[0].([]tasks.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockTaskArchiveMockRecorder) Load() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockTaskArchive)(nil).Load))
+}
+
+// Save mocks base method.
+func (m *MockTaskArchive) Save(arg0 []tasks.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTaskArchiveMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTaskArchive)(nil).Save), arg0)
+}
+
 // MockTaskStorage is a mock of TaskStorage interface.
 type MockTaskStorage struct {
 	ctrl     *gomock.Controller
@@ -49,6 +240,21 @@ func (mr *MockTaskStorageMockRecorder) Load() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockTaskStorage)(nil).Load))
 }
 
+// NextId mocks base method.
+func (m *MockTaskStorage) NextId() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextId")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextId indicates an expected call of NextId.
+func (mr *MockTaskStorageMockRecorder) NextId() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextId", reflect.TypeOf((*MockTaskStorage)(nil).NextId))
+}
+
 // Save mocks base method.
 func (m *MockTaskStorage) Save(arg0 []tasks.Task) error {
 	m.ctrl.T.Helper()
@@ -62,3 +268,84 @@ func (mr *MockTaskStorageMockRecorder) Save(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTaskStorage)(nil).Save), arg0)
 }
+
+// MockTemplateStore is a mock of TemplateStore interface.
+type MockTemplateStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateStoreMockRecorder
+}
+
+// MockTemplateStoreMockRecorder is the mock recorder for MockTemplateStore.
+type MockTemplateStoreMockRecorder struct {
+	mock *MockTemplateStore
+}
+
+// NewMockTemplateStore creates a new mock instance.
+func NewMockTemplateStore(ctrl *gomock.Controller) *MockTemplateStore {
+	mock := &MockTemplateStore{ctrl: ctrl}
+	mock.recorder = &MockTemplateStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTemplateStore) EXPECT() *MockTemplateStoreMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockTemplateStore) Delete(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTemplateStoreMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTemplateStore)(nil).Delete), arg0)
+}
+
+// List mocks base method.
+func (m *MockTemplateStore) List() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTemplateStoreMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTemplateStore)(nil).List))
+}
+
+// Load mocks base method.
+func (m *MockTemplateStore) Load(arg0 string) (tasks.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", arg0)
+	ret0, _ := ret[0].(tasks.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockTemplateStoreMockRecorder) Load(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockTemplateStore)(nil).Load), arg0)
+}
+
+// Save mocks base method.
+func (m *MockTemplateStore) Save(arg0 tasks.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTemplateStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTemplateStore)(nil).Save), arg0)
+}
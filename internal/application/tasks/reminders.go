@@ -0,0 +1,264 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/files"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const reminderLogFileName = "reminders.json"
+
+// defaultReminderHour is the time of day of reminders given as a day only, e.g. "tomorrow".
+const defaultReminderHour = 9
+
+var (
+	relativeReminderPattern = regexp.MustCompile(`^in\s+(\d+)\s*([a-z]+)$`)
+	clockPattern            = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*(am|pm)?$`)
+)
+
+var reminderUnits = map[string]time.Duration{
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hour": time.Hour, "hours": time.Hour,
+}
+
+// reminderDayUnits are counted in calendar days, so that "in 1d" keeps the time of day across a daylight saving change.
+var reminderDayUnits = map[string]int{
+	"d": 1, "day": 1, "days": 1,
+	"w": 7, "week": 7, "weeks": 7,
+}
+
+var reminderDateLayouts = []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02"}
+
+type reminderFileLog struct {
+}
+
+var defaultReminderLog domain.ReminderLog = &reminderFileLog{}
+
+func SetReminder(id int, at time.Time) error {
+	return setReminder(defaultTaskStorage, defaultEventPublisher(), id, at, time.Now)
+}
+
+// FireReminders notifies about every reminder that is due and did not fire yet, and returns the reminded tasks.
+func FireReminders(notifier domain.Notifier) ([]domain.Task, error) {
+	return fireReminders(defaultTaskStorage, defaultReminderLog, notifier, time.Now)
+}
+
+// ParseReminderTime reads the time of a reminder relative to now. It understands
+//
+//	in 30m, in 2 hours, in 3d, in 1 week
+//	9am, 14:30, 9:15pm            today, or tomorrow when the time has passed
+//	today 5pm, tomorrow, friday 9am  a weekday means its next occurrence after today
+//	2025-09-01, 2025-09-01 14:30
+//
+// Dates without a time of day remind at 9:00.
+func ParseReminderTime(value string, now time.Time) (time.Time, error) {
+	value = strings.ToLower(strings.Join(strings.Fields(value), " "))
+
+	if match := relativeReminderPattern.FindStringSubmatch(value); match != nil {
+		amount, _ := strconv.Atoi(match[1])
+
+		if days, ok := reminderDayUnits[match[2]]; ok {
+			return now.AddDate(0, 0, amount*days), nil
+		}
+
+		unit, ok := reminderUnits[match[2]]
+
+		if !ok {
+			return time.Time{}, newInvalidInputError("invalid reminder time %q: unknown unit %q", value, match[2])
+		}
+
+		return now.Add(time.Duration(amount) * unit), nil
+	}
+
+	for _, layout := range reminderDateLayouts {
+		if at, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			if layout == "2006-01-02" {
+				at = time.Date(at.Year(), at.Month(), at.Day(), defaultReminderHour, 0, 0, 0, at.Location())
+			}
+
+			return at, nil
+		}
+	}
+
+	day, clock, _ := strings.Cut(value, " ")
+	date, ok := reminderDay(day, now)
+
+	if !ok {
+		day, clock = "", value
+		date = now
+	}
+
+	hour, minute := defaultReminderHour, 0
+
+	if clock != "" {
+		var err error
+		if hour, minute, err = parseClock(clock); err != nil {
+			return time.Time{}, newInvalidInputError("invalid reminder time %q: %s", value, err.Error())
+		}
+	}
+
+	at := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, now.Location())
+
+	// A bare time of day that has already passed today means tomorrow.
+	if day == "" && !at.After(now) {
+		at = time.Date(date.Year(), date.Month(), date.Day()+1, hour, minute, 0, 0, now.Location())
+	}
+
+	return at, nil
+}
+
+// reminderDay returns the date named by word: today, tomorrow or the next given weekday.
+func reminderDay(word string, now time.Time) (time.Time, bool) {
+	switch word {
+	case "today":
+		return now, true
+	case "tomorrow":
+		return now.AddDate(0, 0, 1), true
+	}
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		name := strings.ToLower(weekday.String())
+
+		if word == name || word == name[:3] {
+			days := (int(weekday)-int(now.Weekday())+6)%7 + 1
+			return now.AddDate(0, 0, days), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// parseClock reads a time of day such as "9am", "9:30 pm" or "14:30".
+func parseClock(value string) (int, int, error) {
+	match := clockPattern.FindStringSubmatch(value)
+
+	if match == nil {
+		return 0, 0, fmt.Errorf("expected a time of day like 9am or 14:30")
+	}
+
+	hour, _ := strconv.Atoi(match[1])
+	minute := 0
+
+	if match[2] != "" {
+		minute, _ = strconv.Atoi(match[2])
+	}
+
+	switch match[3] {
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return 0, 0, fmt.Errorf("hour %d is not valid with %s", hour, match[3])
+		}
+
+		hour %= 12
+		if match[3] == "pm" {
+			hour += 12
+		}
+	}
+
+	if hour > 23 || minute > 59 {
+		return 0, 0, fmt.Errorf("%s is not a valid time of day", value)
+	}
+
+	return hour, minute, nil
+}
+
+// setReminder sets the reminder of a task, a zero time removes it.
+func setReminder(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id int, at time.Time, now func() time.Time) error {
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return err
+	}
+
+	for i := range tasks {
+		if tasks[i].Id == id {
+			tasks[i].RemindAt = at
+			tasks[i].UpdatedAt = now()
+			event := domain.Event{Type: domain.TaskUpdated, Task: tasks[i], OccurredAt: now()}
+
+			if err := publisher.Before(event); err != nil {
+				return err
+			}
+
+			if err := taskStorage.Save(tasks); err != nil {
+				return err
+			}
+
+			publisher.Publish(event)
+			return nil
+		}
+	}
+
+	return newTaskNotFoundError(id)
+}
+
+// fireReminders notifies about the reminders of open tasks whose time has come and records them in the log,
+// so that a reminder fires only once even when the daemon restarts. Failed notifications are retried next time.
+func fireReminders(taskStorage domain.TaskStorage, log domain.ReminderLog, notifier domain.Notifier, now func() time.Time) ([]domain.Task, error) {
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	fired, err := log.Load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the reminders still set on a task are kept, a changed reminder fires again.
+	current := make(map[string]time.Time)
+	var reminded []domain.Task
+	var errs []error
+
+	for _, task := range tasks {
+		if task.RemindAt.IsZero() || task.Uuid == "" {
+			continue
+		}
+
+		if at, ok := fired[task.Uuid]; ok && at.Equal(task.RemindAt) {
+			current[task.Uuid] = at
+			continue
+		}
+
+		if task.RemindAt.After(now()) || task.CurrentStatus == domain.Done {
+			continue
+		}
+
+		if err := notifier.Notify(task); err != nil {
+			errs = append(errs, fmt.Errorf("reminder for task %d: %w", task.Id, err))
+			continue
+		}
+
+		current[task.Uuid] = task.RemindAt
+		reminded = append(reminded, task)
+	}
+
+	if len(current) != len(fired) || len(reminded) > 0 {
+		if err := log.Save(current); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return reminded, errors.Join(errs...)
+}
+
+func (l *reminderFileLog) Load() (map[string]time.Time, error) {
+	fired, err := files.GetFromNamedFile[map[string]time.Time](reminderLogFileName)
+
+	if err == nil && fired == nil {
+		fired = make(map[string]time.Time)
+	}
+
+	return fired, newStorageError(err)
+}
+
+func (l *reminderFileLog) Save(fired map[string]time.Time) error {
+	return newStorageError(files.SaveToNamedFile(reminderLogFileName, fired))
+}
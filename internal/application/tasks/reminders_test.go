@@ -0,0 +1,208 @@
+package tasks
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseReminderTime(t *testing.T) {
+	t.Parallel()
+
+	// A Wednesday.
+	now := time.Date(2025, 9, 10, 10, 30, 0, 0, time.UTC)
+
+	type testCase struct {
+		name        string
+		value       string
+		expected    time.Time
+		expectedErr string
+	}
+
+	tests := []testCase{
+		{name: "Minutes from now", value: "in 30m", expected: now.Add(30 * time.Minute)},
+		{name: "Hours from now", value: "in 2 hours", expected: now.Add(2 * time.Hour)},
+		{name: "Weeks from now", value: "In 1 week", expected: now.AddDate(0, 0, 7)},
+		{name: "Tomorrow morning", value: "tomorrow 9am", expected: time.Date(2025, 9, 11, 9, 0, 0, 0, time.UTC)},
+		{name: "Tomorrow without time", value: "tomorrow", expected: time.Date(2025, 9, 11, 9, 0, 0, 0, time.UTC)},
+		{name: "Today in the afternoon", value: "today 5:15pm", expected: time.Date(2025, 9, 10, 17, 15, 0, 0, time.UTC)},
+		{name: "Later today", value: "14:30", expected: time.Date(2025, 9, 10, 14, 30, 0, 0, time.UTC)},
+		{name: "Passed time means tomorrow", value: "9am", expected: time.Date(2025, 9, 11, 9, 0, 0, 0, time.UTC)},
+		{name: "Midnight", value: "tomorrow 12am", expected: time.Date(2025, 9, 11, 0, 0, 0, 0, time.UTC)},
+		{name: "Next weekday", value: "friday 8am", expected: time.Date(2025, 9, 12, 8, 0, 0, 0, time.UTC)},
+		{name: "Same weekday is next week", value: "wed", expected: time.Date(2025, 9, 17, 9, 0, 0, 0, time.UTC)},
+		{name: "Date", value: "2025-10-01", expected: time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC)},
+		{name: "Date and time", value: "2025-10-01 18:45", expected: time.Date(2025, 10, 1, 18, 45, 0, 0, time.UTC)},
+		{name: "Unknown unit", value: "in 3 fortnights", expectedErr: `invalid reminder time "in 3 fortnights": unknown unit "fortnights"`},
+		{name: "Invalid hour", value: "tomorrow 13pm", expectedErr: `invalid reminder time "tomorrow 13pm": hour 13 is not valid with pm`},
+		{name: "Invalid time of day", value: "25:00", expectedErr: `invalid reminder time "25:00": 25:00 is not a valid time of day`},
+		{name: "Gibberish", value: "someday", expectedErr: `invalid reminder time "someday": expected a time of day like 9am or 14:30`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			at, err := ParseReminderTime(tt.value, now)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, at)
+		})
+	}
+}
+
+func TestParseReminderTimeAcrossDaylightSavingTime(t *testing.T) {
+	t.Parallel()
+
+	berlin := loadLocation(t, "Europe/Berlin")
+	// The clocks go back from 3:00 to 2:00 on Sunday, 2025-10-26.
+	now := time.Date(2025, 10, 25, 10, 30, 0, 0, berlin)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Time
+	}{
+		{name: "Days keep the time of day", value: "in 1d", expected: time.Date(2025, 10, 26, 10, 30, 0, 0, berlin)},
+		{name: "Weeks keep the time of day", value: "in 1 week", expected: time.Date(2025, 11, 1, 10, 30, 0, 0, berlin)},
+		{name: "Hours are elapsed time", value: "in 24h", expected: time.Date(2025, 10, 26, 9, 30, 0, 0, berlin)},
+		{name: "Date on the day of the change", value: "2025-10-26", expected: time.Date(2025, 10, 26, 9, 0, 0, 0, berlin)},
+		{name: "Date on the day clocks go forward", value: "2026-03-29", expected: time.Date(2026, 3, 29, 9, 0, 0, 0, berlin)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			at, err := ParseReminderTime(tt.value, now)
+
+			assert.NoError(t, err)
+			assert.True(t, tt.expected.Equal(at), "expected %s, got %s", tt.expected, at)
+		})
+	}
+}
+
+func TestSetReminder(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 9, 10, 10, 0, 0, 0, time.UTC)
+	remindAt := time.Date(2025, 9, 11, 9, 0, 0, 0, time.UTC)
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+
+	storage.EXPECT().Load().Return([]domain.Task{{Id: 1, Description: "Call mom"}}, nil).Times(1)
+	expected := []domain.Task{{Id: 1, Description: "Call mom", RemindAt: remindAt, UpdatedAt: now}}
+	event := domain.Event{Type: domain.TaskUpdated, Task: expected[0], OccurredAt: now}
+	publisher.EXPECT().Before(event).Return(nil).Times(1)
+	storage.EXPECT().Save(expected).Return(nil).Times(1)
+	publisher.EXPECT().Publish(event).Times(1)
+
+	err := setReminder(storage, publisher, 1, remindAt, func() time.Time { return now })
+	assert.NoError(t, err)
+
+	storage.EXPECT().Load().Return([]domain.Task{{Id: 1}}, nil).Times(1)
+	err = setReminder(storage, publisher, 2, remindAt, func() time.Time { return now })
+	assert.ErrorIs(t, err, ErrTaskNotFound)
+}
+
+func TestFireReminders(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 9, 10, 10, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	later := now.Add(time.Hour)
+
+	due := domain.Task{Id: 1, Uuid: "uuid-1", Description: "Call mom", RemindAt: earlier}
+	upcoming := domain.Task{Id: 2, Uuid: "uuid-2", Description: "Pay rent", RemindAt: later}
+	done := domain.Task{Id: 3, Uuid: "uuid-3", Description: "Buy milk", RemindAt: earlier, CurrentStatus: domain.Done}
+	rescheduled := domain.Task{Id: 4, Uuid: "uuid-4", Description: "Water plants", RemindAt: now}
+
+	type testCase struct {
+		name          string
+		tasks         []domain.Task
+		fired         map[string]time.Time
+		notifyErr     error
+		expected      []domain.Task
+		expectedSaved map[string]time.Time
+		expectedErr   string
+	}
+
+	tests := []testCase{
+		{
+			name:          "Due reminders fire once",
+			tasks:         []domain.Task{due, upcoming, done},
+			fired:         map[string]time.Time{},
+			expected:      []domain.Task{due},
+			expectedSaved: map[string]time.Time{"uuid-1": earlier},
+		},
+		{
+			name:  "Fired reminders are not repeated",
+			tasks: []domain.Task{due, upcoming},
+			fired: map[string]time.Time{"uuid-1": earlier},
+		},
+		{
+			name:          "Changed reminders fire again and removed ones are forgotten",
+			tasks:         []domain.Task{rescheduled, upcoming},
+			fired:         map[string]time.Time{"uuid-4": earlier, "uuid-9": earlier},
+			expected:      []domain.Task{rescheduled},
+			expectedSaved: map[string]time.Time{"uuid-4": now},
+		},
+		{
+			name:        "Failed notifications are retried",
+			tasks:       []domain.Task{due},
+			fired:       map[string]time.Time{},
+			notifyErr:   assert.AnError,
+			expectedErr: "reminder for task 1: " + assert.AnError.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mocks.NewMockTaskStorage(ctrl)
+			log := mocks.NewMockReminderLog(ctrl)
+			notifier := mocks.NewMockNotifier(ctrl)
+
+			storage.EXPECT().Load().Return(tt.tasks, nil).Times(1)
+			log.EXPECT().Load().Return(tt.fired, nil).Times(1)
+			notifier.EXPECT().Notify(gomock.Any()).Return(tt.notifyErr).Times(len(tt.expected))
+
+			if tt.notifyErr != nil {
+				notifier.EXPECT().Notify(due).Return(tt.notifyErr).Times(1)
+			}
+
+			if tt.expectedSaved != nil {
+				log.EXPECT().Save(tt.expectedSaved).Return(nil).Times(1)
+			}
+
+			reminded, err := fireReminders(storage, log, notifier, func() time.Time { return now })
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expected, reminded)
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package tasks
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	tagScore         = 4
+	exactTokenScore  = 3
+	tokenPrefixScore = 2
+	substringScore   = 1
+)
+
+type searchResult struct {
+	task    domain.Task
+	score   int
+	matches []textRange
+}
+
+// textRange is a half-open byte range [start, end) inside the task description.
+type textRange struct {
+	start int
+	end   int
+}
+
+func SearchTasks(terms []string, regex bool) (string, error) {
+	return getSearchTasksList(defaultTaskStorage, terms, regex)
+}
+
+func getSearchTasksList(storage domain.TaskStorage, terms []string, regex bool) (string, error) {
+	tasks, err := storage.Load()
+
+	if err != nil {
+		return "", err
+	}
+
+	results, err := searchTasks(tasks, terms, regex)
+
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	builder.WriteString(getTaskListHeader())
+
+	for _, result := range results {
+		builder.WriteString(getSearchResultDescription(result, taskRenderer))
+	}
+
+	return builder.String(), nil
+}
+
+// searchTasks returns the tasks matching the query, best matches first.
+// In token mode every query token has to occur in the task, in regex mode the terms
+// are joined with spaces and used as a single case-insensitive regular expression.
+// Both modes look at the description and the tags of a task.
+func searchTasks(tasks []domain.Task, terms []string, regex bool) ([]searchResult, error) {
+	var matcher func(task domain.Task) (int, []textRange)
+
+	if regex {
+		pattern, err := regexp.Compile("(?i)" + strings.Join(terms, " "))
+
+		if err != nil {
+			return nil, &invalidSearchError{message: fmt.Sprintf("invalid regular expression: %s", err.Error())}
+		}
+
+		matcher = func(task domain.Task) (int, []textRange) {
+			return matchRegex(pattern, task.Description, task.Tags())
+		}
+	} else {
+		queryTokens := tokenize(strings.Join(terms, " "))
+
+		if len(queryTokens) == 0 {
+			return nil, &invalidSearchError{message: "search terms are required"}
+		}
+
+		matcher = func(task domain.Task) (int, []textRange) {
+			return matchTokens(queryTokens, task.Description, task.Tags())
+		}
+	}
+
+	var results []searchResult
+
+	for _, task := range tasks {
+		score, matches := matcher(task)
+
+		if score > 0 {
+			results = append(results, searchResult{task: task, score: score, matches: matches})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].score != results[j].score {
+			return results[i].score > results[j].score
+		}
+
+		return results[i].task.Id < results[j].task.Id
+	})
+
+	return results, nil
+}
+
+// matchRegex scores one point per match in the text and tagScore per tag the pattern
+// matches, the tag written with its prefix.
+func matchRegex(pattern *regexp.Regexp, text string, tags []string) (int, []textRange) {
+	var matches []textRange
+
+	for _, loc := range pattern.FindAllStringIndex(text, -1) {
+		if loc[1] > loc[0] {
+			matches = append(matches, textRange{start: loc[0], end: loc[1]})
+		}
+	}
+
+	score := len(matches)
+
+	for _, tag := range tags {
+		if pattern.MatchString(domain.TagPrefix + tag) {
+			score += tagScore
+		}
+	}
+
+	return score, matches
+}
+
+// matchTokens scores text against every query token. A token scores highest when it
+// names one of the tags, less when it equals a word of the text, less again when it
+// prefixes a word and least when it is found inside a word. Zero is returned as soon
+// as one of the tokens is missing.
+func matchTokens(queryTokens []string, text string, tags []string) (int, []textRange) {
+	lowerText := strings.ToLower(text)
+	textTokens := tokenRanges(lowerText)
+	total := 0
+	var matches []textRange
+
+	for _, query := range queryTokens {
+		best := 0
+
+		for _, tokenRange := range textTokens {
+			word := lowerText[tokenRange.start:tokenRange.end]
+			idx := strings.Index(word, query)
+
+			if idx < 0 {
+				continue
+			}
+
+			score := substringScore
+
+			if word == query {
+				score = exactTokenScore
+			} else if idx == 0 {
+				score = tokenPrefixScore
+			}
+
+			best = max(best, score)
+			matches = append(matches, textRange{
+				start: tokenRange.start + idx,
+				end:   tokenRange.start + idx + len(query),
+			})
+		}
+
+		if slices.Contains(tags, query) {
+			best = tagScore
+		}
+
+		if best == 0 {
+			return 0, nil
+		}
+
+		total += best
+	}
+
+	// Lower-casing can change byte offsets for a few scripts, highlighting is skipped then.
+	if len(lowerText) != len(text) {
+		return total, nil
+	}
+
+	return total, mergeRanges(matches)
+}
+
+func tokenize(text string) []string {
+	lowerText := strings.ToLower(text)
+	var tokens []string
+
+	for _, tokenRange := range tokenRanges(lowerText) {
+		tokens = append(tokens, lowerText[tokenRange.start:tokenRange.end])
+	}
+
+	return tokens
+}
+
+func tokenRanges(text string) []textRange {
+	var ranges []textRange
+	start := -1
+
+	for i, r := range text {
+		isTokenRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+
+		if isTokenRune && start < 0 {
+			start = i
+		} else if !isTokenRune && start >= 0 {
+			ranges = append(ranges, textRange{start: start, end: i})
+			start = -1
+		}
+	}
+
+	if start >= 0 {
+		ranges = append(ranges, textRange{start: start, end: len(text)})
+	}
+
+	return ranges
+}
+
+func mergeRanges(ranges []textRange) []textRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].start < ranges[j].start
+	})
+
+	merged := []textRange{ranges[0]}
+
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+
+		if r.start <= last.end {
+			last.end = max(last.end, r.end)
+		} else {
+			merged = append(merged, r)
+		}
+	}
+
+	return merged
+}
+
+func highlight(text string, matches []textRange, r *renderer.Renderer) string {
+	var builder strings.Builder
+	pos := 0
+
+	for _, match := range matches {
+		builder.WriteString(text[pos:match.start])
+		builder.WriteString(r.Paint(renderer.Highlight, text[match.start:match.end]))
+		pos = match.end
+	}
+
+	builder.WriteString(text[pos:])
+	return builder.String()
+}
+
+// getSearchResultDescription renders a task row like getTaskShortDescription,
+// padding the description by its visible width so highlighting keeps the columns aligned.
+func getSearchResultDescription(result searchResult, r *renderer.Renderer) string {
+	description := highlight(result.task.Description, result.matches, r)
+	padding := max(0, 20-len([]rune(result.task.Description)))
+
+	return fmt.Sprintf("%-3d %s%s %s %-16s %-16s\n",
+		result.task.Id,
+		description,
+		strings.Repeat(" ", padding),
+		r.Status(result.task.CurrentStatus, fmt.Sprintf("%-12s", result.task.CurrentStatus.String())),
+		FormatDate(result.task.CreatedAt),
+		FormatDate(result.task.UpdatedAt),
+	)
+}
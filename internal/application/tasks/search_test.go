@@ -0,0 +1,248 @@
+package tasks
+
+import (
+	"fmt"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+var searchFixtureTasks = []domain.Task{
+	{Id: 1, Description: "Buy groceries", CurrentStatus: domain.Todo, CreatedAt: time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)},
+	{Id: 2, Description: "Buy milk and bread", CurrentStatus: domain.InProgress, CreatedAt: time.Date(2025, 9, 2, 10, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 2, 11, 0, 0, 0, time.UTC)},
+	{Id: 3, Description: "Rebuy domain name", CurrentStatus: domain.Done, CreatedAt: time.Date(2025, 9, 3, 10, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 4, 10, 0, 0, 0, time.UTC)},
+	{Id: 4, Description: "Buyer meeting", CurrentStatus: domain.Todo, CreatedAt: time.Date(2025, 9, 5, 10, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 5, 10, 0, 0, 0, time.UTC)},
+	{Id: 5, Description: "Write release notes", CurrentStatus: domain.Todo, CreatedAt: time.Date(2025, 9, 6, 10, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 6, 10, 0, 0, 0, time.UTC)},
+}
+
+func TestSearchTasks(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		terms       []string
+		regex       bool
+		expectedIds []int
+		expectedErr error
+	}
+
+	tests := []testCase{
+		{
+			name:        "Exact words rank above prefixes and substrings",
+			terms:       []string{"buy"},
+			expectedIds: []int{1, 2, 4, 3},
+		},
+		{
+			name:        "Case insensitive",
+			terms:       []string{"MILK"},
+			expectedIds: []int{2},
+		},
+		{
+			name:        "Every token has to match",
+			terms:       []string{"buy", "bread"},
+			expectedIds: []int{2},
+		},
+		{
+			name:        "Tokens inside a single term",
+			terms:       []string{"release-notes"},
+			expectedIds: []int{5},
+		},
+		{
+			name:        "No matches",
+			terms:       []string{"holiday"},
+			expectedIds: nil,
+		},
+		{
+			name:        "Regex",
+			terms:       []string{"^buy", "(milk|groceries)"},
+			regex:       true,
+			expectedIds: []int{1, 2},
+		},
+		{
+			name:        "Regex ranks by number of matches",
+			terms:       []string{"e"},
+			regex:       true,
+			expectedIds: []int{5, 4, 1, 3, 2},
+		},
+		{
+			name:        "Invalid regex",
+			terms:       []string{"("},
+			regex:       true,
+			expectedErr: fmt.Errorf("invalid regular expression: error parsing regexp: missing closing ): `(?i)(`"),
+		},
+		{
+			name:        "Empty query",
+			terms:       []string{" - "},
+			expectedErr: fmt.Errorf("search terms are required"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			results, err := searchTasks(searchFixtureTasks, tt.terms, tt.regex)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+
+			var ids []int
+			for _, result := range results {
+				ids = append(ids, result.task.Id)
+			}
+
+			assert.Equal(t, tt.expectedIds, ids)
+		})
+	}
+}
+
+func TestSearchTasksByTag(t *testing.T) {
+	t.Parallel()
+
+	tasks := []domain.Task{
+		{Id: 1, Description: "Plan the release party"},
+		{Id: 2, Description: "Tag the build #release"},
+		{Id: 3, Description: "Write the changelog #release-notes"},
+		{Id: 4, Description: "Fix the login page #urgent"},
+	}
+
+	type testCase struct {
+		name        string
+		terms       []string
+		regex       bool
+		expectedIds []int
+	}
+
+	tests := []testCase{
+		{
+			name:        "A tag ranks above a word",
+			terms:       []string{"release"},
+			expectedIds: []int{2, 1, 3},
+		},
+		{
+			name:        "Tag written with its prefix",
+			terms:       []string{"#urgent"},
+			expectedIds: []int{4},
+		},
+		{
+			name:        "Tag of several words",
+			terms:       []string{"#release-notes"},
+			expectedIds: []int{3},
+		},
+		{
+			name:        "Regex over tags",
+			terms:       []string{"#release"},
+			regex:       true,
+			expectedIds: []int{2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			results, err := searchTasks(tasks, tt.terms, tt.regex)
+			assert.NoError(t, err)
+
+			var ids []int
+			for _, result := range results {
+				ids = append(ids, result.task.Id)
+			}
+
+			assert.Equal(t, tt.expectedIds, ids)
+		})
+	}
+}
+
+// highlightStart and highlightEnd surround a match with the default highlight style, bold and underlined.
+const (
+	highlightStart = "\033[1;4m"
+	highlightEnd   = "\033[0m"
+)
+
+func TestHighlight(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		text     string
+		terms    []string
+		expected string
+	}
+
+	tests := []testCase{
+		{
+			name:     "Single match",
+			text:     "Buy groceries",
+			terms:    []string{"groc"},
+			expected: "Buy " + highlightStart + "groc" + highlightEnd + "eries",
+		},
+		{
+			name:     "Several matches keep original case",
+			text:     "Buy milk, buy bread",
+			terms:    []string{"BUY"},
+			expected: highlightStart + "Buy" + highlightEnd + " milk, " + highlightStart + "buy" + highlightEnd + " bread",
+		},
+		{
+			name:     "Overlapping matches are merged",
+			text:     "Rebuy",
+			terms:    []string{"reb", "ebuy"},
+			expected: highlightStart + "Rebuy" + highlightEnd,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, matches := matchTokens(tokenize(strings.Join(tt.terms, " ")), tt.text, nil)
+			assert.Equal(t, tt.expected, highlight(tt.text, matches, renderer.New(true, renderer.DefaultTheme())))
+			assert.Equal(t, tt.text, highlight(tt.text, matches, renderer.Plain()))
+		})
+	}
+}
+
+func TestGetSearchTasksList(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	storage.EXPECT().Load().Return(searchFixtureTasks, nil).Times(1)
+
+	out, err := getSearchTasksList(storage, []string{"milk"}, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, getTaskListHeader()+
+		"2   Buy milk and bread   in-progress  2025-09-02 10:00 2025-09-02 11:00\n", out)
+
+	storage.EXPECT().Load().Return(nil, assert.AnError).Times(1)
+
+	_, err = getSearchTasksList(storage, []string{"milk"}, false)
+	assert.EqualError(t, err, assert.AnError.Error())
+}
+
+func TestGetSearchResultDescriptionColored(t *testing.T) {
+	t.Parallel()
+
+	results, err := searchTasks(searchFixtureTasks, []string{"milk"}, false)
+	assert.NoError(t, err)
+
+	out := getSearchResultDescription(results[0], renderer.New(true, renderer.DefaultTheme()))
+
+	assert.Equal(t, "2   Buy "+highlightStart+"milk"+highlightEnd+" and bread   "+
+		"\033[36min-progress \033[0m 2025-09-02 10:00 2025-09-02 11:00\n", out)
+}
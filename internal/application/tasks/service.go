@@ -0,0 +1,90 @@
+package tasks
+
+import (
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"time"
+)
+
+// Service offers the task operations on an explicit storage. The package level functions
+// use the default file storage, a Service is meant for long running callers such as the
+// HTTP API that get their storage injected.
+type Service struct {
+	storage   domain.TaskStorage
+	publisher domain.EventPublisher
+	now       func() time.Time
+	newUuid   func() string
+	// user creates the tasks added through the Service.
+	user string
+	// close releases what the Service started, nil when there is nothing to release.
+	close func()
+}
+
+func NewService(storage domain.TaskStorage, publisher domain.EventPublisher, now func() time.Time) *Service {
+	return &Service{storage: storage, publisher: publisher, now: now, newUuid: newUuid, user: currentUser}
+}
+
+// DefaultService returns a Service backed by the default file storage, the configured webhooks and the system clock.
+// Webhooks are delivered in the background, the Service is meant to outlive the calls and to be closed after them.
+func DefaultService() *Service {
+	background := newBackgroundPublisher(&webhookPublisher{webhooks: configuredWebhooks}, backgroundQueueSize,
+		&appendLog{fileName: webhooksLogFileName})
+
+	service := NewService(defaultTaskStorage, newEventPublishers(background), time.Now)
+	service.close = background.Close
+
+	return service
+}
+
+// Close waits for the events of the calls so far to be delivered.
+func (s *Service) Close() {
+	if s.close != nil {
+		s.close()
+	}
+}
+
+func (s *Service) AddTask(description string) (domain.Task, error) {
+	return addTask(s.storage, s.publisher, description, s.user, s.now, s.newUuid)
+}
+
+// EditTask changes the description and/or the status of a task, nil leaves the field as it is.
+func (s *Service) EditTask(id int, description *string, status *domain.Status) (domain.Task, error) {
+	return editTask(s.storage, s.publisher, id, description, status, s.now)
+}
+
+func (s *Service) DeleteTask(id int) error {
+	return deleteTask(s.storage, s.publisher, id, s.now)
+}
+
+func (s *Service) ResolveTaskId(ref string) (int, error) {
+	return resolveTaskId(s.storage, ref)
+}
+
+func (s *Service) ListTasks() ([]domain.Task, error) {
+	return s.storage.Load()
+}
+
+func (s *Service) GetTask(id int) (domain.Task, error) {
+	return findTask(s.storage, id)
+}
+
+// SearchTasks returns the tasks matching the search terms, best matches first.
+func (s *Service) SearchTasks(terms []string, regex bool) ([]domain.Task, error) {
+	tasks, err := s.storage.Load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := searchTasks(tasks, terms, regex)
+
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]domain.Task, 0, len(results))
+	for _, result := range results {
+		found = append(found, result.task)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,73 @@
+package tasks
+
+import (
+	"errors"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestServiceGetTask(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existingTasks := []domain.Task{
+		{Id: 1, Description: "First"},
+		{Id: 4, Description: "Second"},
+	}
+
+	type testCase struct {
+		name        string
+		id          int
+		loadErr     error
+		expected    domain.Task
+		expectedErr error
+		notFound    bool
+	}
+
+	tests := []testCase{
+		{
+			name:     "Existing task",
+			id:       4,
+			expected: existingTasks[1],
+		},
+		{
+			name:        "Missing task",
+			id:          2,
+			expectedErr: errors.New("task with id [2] not found"),
+			notFound:    true,
+		},
+		{
+			name:        "Storage Load Error",
+			id:          1,
+			loadErr:     assert.AnError,
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewMockTaskStorage(ctrl)
+			storage.EXPECT().Load().Return(existingTasks, tt.loadErr).Times(1)
+
+			service := NewService(storage, mocks.NewMockEventPublisher(ctrl), time.Now)
+			task, err := service.GetTask(tt.id)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				assert.Equal(t, tt.notFound, errors.Is(err, ErrTaskNotFound))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, task)
+			}
+		})
+	}
+}
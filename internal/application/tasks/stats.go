@@ -0,0 +1,342 @@
+package tasks
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultStatsDays = 28
+	// maxStatsDays bounds the range of the report, the weekly summary and the burndown go through every day of it.
+	maxStatsDays     = 366
+	oldestOpenCount  = 5
+	burndownBarWidth = 40
+	unassignedName   = "(unassigned)"
+)
+
+// StatsDateLayout is the layout of the days in the statistics and of the range they are asked for.
+const StatsDateLayout = "2006-01-02"
+
+type weekStats struct {
+	start     time.Time
+	created   int
+	completed int
+}
+
+// userStats counts the tasks assigned to and created by a user.
+type userStats struct {
+	name         string
+	statusCounts map[domain.Status]int
+	created      int
+}
+
+type burndownPoint struct {
+	day  time.Time
+	open int
+}
+
+type statsReport struct {
+	from         time.Time
+	to           time.Time
+	statusCounts map[domain.Status]int
+	users        []userStats
+	weeks        []weekStats
+	leadTime     time.Duration
+	leadTimeOf   int
+	oldestOpen   []domain.Task
+	burndown     []burndownPoint
+	now          time.Time
+}
+
+// GetStats renders the statistics report for the days between from and to (both inclusive).
+// Zero dates default to a window of the last four weeks ending today.
+func GetStats(from, to time.Time) (string, error) {
+	return getStats(defaultTaskStorage, from, to, Now)
+}
+
+func getStats(storage domain.TaskStorage, from, to time.Time, now func() time.Time) (string, error) {
+	tasks, err := storage.Load()
+
+	if err != nil {
+		return "", err
+	}
+
+	report, err := buildStatsReport(tasks, from, to, now())
+
+	if err != nil {
+		return "", err
+	}
+
+	return renderStatsReport(report), nil
+}
+
+func buildStatsReport(tasks []domain.Task, from, to, now time.Time) (statsReport, error) {
+	loc := now.Location()
+
+	if to.IsZero() {
+		to = now
+	}
+
+	if from.IsZero() {
+		from = startOfDay(to.In(loc)).AddDate(0, 0, -(defaultStatsDays - 1))
+	}
+
+	from, to = startOfDay(from.In(loc)), startOfDay(to.In(loc))
+
+	if to.Before(from) {
+		return statsReport{}, newInvalidInputError("invalid date range: %s is after %s",
+			from.Format(StatsDateLayout), to.Format(StatsDateLayout))
+	}
+
+	if from.AddDate(0, 0, maxStatsDays-1).Before(to) {
+		return statsReport{}, newInvalidInputError("invalid date range: %s to %s is longer than %d days",
+			from.Format(StatsDateLayout), to.Format(StatsDateLayout), maxStatsDays)
+	}
+
+	report := statsReport{
+		from:         from,
+		to:           to,
+		statusCounts: make(map[domain.Status]int),
+		now:          now,
+	}
+
+	var totalLeadTime time.Duration
+
+	for _, task := range tasks {
+		report.statusCounts[task.CurrentStatus]++
+
+		if completedAt, ok := completionTime(task); ok {
+			totalLeadTime += completedAt.Sub(task.CreatedAt)
+			report.leadTimeOf++
+		} else {
+			report.oldestOpen = append(report.oldestOpen, task)
+		}
+	}
+
+	if report.leadTimeOf > 0 {
+		report.leadTime = totalLeadTime / time.Duration(report.leadTimeOf)
+	}
+
+	sort.SliceStable(report.oldestOpen, func(i, j int) bool {
+		return report.oldestOpen[i].CreatedAt.Before(report.oldestOpen[j].CreatedAt)
+	})
+
+	if len(report.oldestOpen) > oldestOpenCount {
+		report.oldestOpen = report.oldestOpen[:oldestOpenCount]
+	}
+
+	report.users = buildUserStats(tasks)
+	report.weeks = buildWeekStats(tasks, from, to)
+	report.burndown = buildBurndown(tasks, from, to)
+
+	return report, nil
+}
+
+// completionTime reports when a done task was completed. Tasks completed before
+// completion times were tracked fall back to their last update.
+func completionTime(task domain.Task) (time.Time, bool) {
+	if task.CurrentStatus != domain.Done {
+		return time.Time{}, false
+	}
+
+	if task.CompletedAt.IsZero() {
+		return task.UpdatedAt, true
+	}
+
+	return task.CompletedAt, true
+}
+
+// buildUserStats summarises the tasks per assignee and creator in alphabetical order, followed by the
+// unassigned tasks. Task lists without any users, e.g. from before tasks had owners, have no summary.
+func buildUserStats(tasks []domain.Task) []userStats {
+	names := taskUsers(tasks)
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	users := make([]userStats, len(names))
+	index := make(map[string]int, len(names))
+
+	for i, name := range names {
+		users[i] = userStats{name: name, statusCounts: make(map[domain.Status]int)}
+		index[strings.ToLower(name)] = i
+	}
+
+	unassigned := userStats{name: unassignedName, statusCounts: make(map[domain.Status]int)}
+
+	for _, task := range tasks {
+		if task.Assignee == "" {
+			unassigned.statusCounts[task.CurrentStatus]++
+		} else {
+			users[index[strings.ToLower(task.Assignee)]].statusCounts[task.CurrentStatus]++
+		}
+
+		if task.CreatedBy != "" {
+			users[index[strings.ToLower(task.CreatedBy)]].created++
+		}
+	}
+
+	if len(unassigned.statusCounts) > 0 {
+		users = append(users, unassigned)
+	}
+
+	return users
+}
+
+func buildWeekStats(tasks []domain.Task, from, to time.Time) []weekStats {
+	var weeks []weekStats
+	end := to.AddDate(0, 0, 1)
+
+	for weekStart := startOfWeek(from); weekStart.Before(end); weekStart = weekStart.AddDate(0, 0, 7) {
+		week := weekStats{start: weekStart}
+		weekEnd := weekStart.AddDate(0, 0, 7)
+
+		for _, task := range tasks {
+			if inRange(task.CreatedAt, weekStart, weekEnd) {
+				week.created++
+			}
+
+			if completedAt, ok := completionTime(task); ok && inRange(completedAt, weekStart, weekEnd) {
+				week.completed++
+			}
+		}
+
+		weeks = append(weeks, week)
+	}
+
+	return weeks
+}
+
+// buildBurndown counts the tasks that were open at the end of every day in the range.
+func buildBurndown(tasks []domain.Task, from, to time.Time) []burndownPoint {
+	var points []burndownPoint
+
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		dayEnd := day.AddDate(0, 0, 1)
+		point := burndownPoint{day: day}
+
+		for _, task := range tasks {
+			if !task.CreatedAt.Before(dayEnd) {
+				continue
+			}
+
+			if completedAt, ok := completionTime(task); !ok || !completedAt.Before(dayEnd) {
+				point.open++
+			}
+		}
+
+		points = append(points, point)
+	}
+
+	return points
+}
+
+func renderStatsReport(report statsReport) string {
+	var builder strings.Builder
+
+	builder.WriteString("Tasks by status\n")
+	for _, status := range []domain.Status{domain.Todo, domain.InProgress, domain.Done} {
+		builder.WriteString(fmt.Sprintf("  %-12s %d\n", status.String(), report.statusCounts[status]))
+	}
+
+	if len(report.users) > 0 {
+		builder.WriteString("\nTasks by user\n")
+		builder.WriteString(fmt.Sprintf("  %-14s %-6s %-12s %-6s %s\n", "User", "Todo", "In progress", "Done", "Created"))
+	}
+	for _, user := range report.users {
+		builder.WriteString(fmt.Sprintf("  %-14s %-6d %-12d %-6d %d\n", user.name,
+			user.statusCounts[domain.Todo], user.statusCounts[domain.InProgress], user.statusCounts[domain.Done], user.created))
+	}
+
+	builder.WriteString(fmt.Sprintf("\nCreated vs. completed per week (%s - %s)\n",
+		report.from.Format(StatsDateLayout), report.to.Format(StatsDateLayout)))
+	builder.WriteString(fmt.Sprintf("  %-12s %-8s %-9s\n", "Week of", "Created", "Completed"))
+	for _, week := range report.weeks {
+		builder.WriteString(fmt.Sprintf("  %-12s %-8d %-9d\n", week.start.Format(StatsDateLayout), week.created, week.completed))
+	}
+
+	builder.WriteString("\nAverage lead time: ")
+	if report.leadTimeOf == 0 {
+		builder.WriteString("n/a (no completed tasks)\n")
+	} else {
+		builder.WriteString(fmt.Sprintf("%s (%d completed tasks)\n", formatLeadTime(report.leadTime), report.leadTimeOf))
+	}
+
+	builder.WriteString("\nOldest open tasks\n")
+	if len(report.oldestOpen) == 0 {
+		builder.WriteString("  none\n")
+	}
+	for _, task := range report.oldestOpen {
+		builder.WriteString(fmt.Sprintf("  %-3d %-20s %-12s %s (%s old)\n",
+			task.Id,
+			task.Description,
+			task.CurrentStatus.String(),
+			formatDate(task.CreatedAt, report.now),
+			formatLeadTime(report.now.Sub(task.CreatedAt)),
+		))
+	}
+
+	builder.WriteString("\nBurndown (open tasks at end of day)\n")
+	builder.WriteString(renderBurndown(report.burndown))
+
+	return builder.String()
+}
+
+func renderBurndown(points []burndownPoint) string {
+	maxOpen := 0
+	for _, point := range points {
+		maxOpen = max(maxOpen, point.open)
+	}
+
+	var builder strings.Builder
+
+	for _, point := range points {
+		width := 0
+		if maxOpen > 0 {
+			width = (point.open*burndownBarWidth + maxOpen - 1) / maxOpen
+		}
+
+		builder.WriteString(fmt.Sprintf("  %s |%s %d\n",
+			point.day.Format(StatsDateLayout), strings.Repeat("#", width), point.open))
+	}
+
+	return builder.String()
+}
+
+// formatLeadTime renders a duration in days and hours, which is the useful precision for task ages.
+func formatLeadTime(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+
+	days := int(d / (24 * time.Hour))
+	hours := int((d % (24 * time.Hour)) / time.Hour)
+
+	if days == 0 {
+		if hours == 0 {
+			return fmt.Sprintf("%dm", int(d/time.Minute))
+		}
+
+		return fmt.Sprintf("%dh", hours)
+	}
+
+	return fmt.Sprintf("%dd %dh", days, hours)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// startOfWeek returns the Monday that starts the week containing t.
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return startOfDay(t).AddDate(0, 0, -offset)
+}
+
+func inRange(t, from, to time.Time) bool {
+	return !t.Before(from) && t.Before(to)
+}
@@ -0,0 +1,182 @@
+package tasks
+
+import (
+	"fmt"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+var statsNow = time.Date(2025, 9, 17, 18, 0, 0, 0, time.UTC)
+
+var statsFixtureTasks = []domain.Task{
+	{Id: 1, Description: "Old todo", CurrentStatus: domain.Todo, CreatedAt: time.Date(2025, 8, 20, 9, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 8, 20, 9, 0, 0, 0, time.UTC)},
+	{Id: 2, Description: "Started", CurrentStatus: domain.InProgress, CreatedAt: time.Date(2025, 9, 8, 9, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 9, 9, 0, 0, 0, time.UTC)},
+	{Id: 3, Description: "Finished", CurrentStatus: domain.Done, CreatedAt: time.Date(2025, 9, 8, 12, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 12, 12, 0, 0, 0, time.UTC), CompletedAt: time.Date(2025, 9, 10, 12, 0, 0, 0, time.UTC)},
+	{Id: 4, Description: "Legacy done", CurrentStatus: domain.Done, CreatedAt: time.Date(2025, 9, 15, 8, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 16, 8, 0, 0, 0, time.UTC)},
+	{Id: 5, Description: "New todo", CurrentStatus: domain.Todo, CreatedAt: time.Date(2025, 9, 16, 8, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2025, 9, 16, 8, 0, 0, 0, time.UTC)},
+}
+
+func TestBuildStatsReport(t *testing.T) {
+	t.Parallel()
+
+	report, err := buildStatsReport(statsFixtureTasks,
+		time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC), time.Date(2025, 9, 17, 0, 0, 0, 0, time.UTC), statsNow)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[domain.Status]int{domain.Todo: 2, domain.InProgress: 1, domain.Done: 2}, report.statusCounts)
+
+	assert.Equal(t, []weekStats{
+		{start: time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC), created: 2, completed: 1},
+		{start: time.Date(2025, 9, 15, 0, 0, 0, 0, time.UTC), created: 2, completed: 1},
+	}, report.weeks)
+
+	// (2 days + 1 day) / 2
+	assert.Equal(t, 36*time.Hour, report.leadTime)
+	assert.Equal(t, 2, report.leadTimeOf)
+
+	var oldestIds []int
+	for _, task := range report.oldestOpen {
+		oldestIds = append(oldestIds, task.Id)
+	}
+	assert.Equal(t, []int{1, 2, 5}, oldestIds)
+
+	var openPerDay []int
+	for _, point := range report.burndown {
+		openPerDay = append(openPerDay, point.open)
+	}
+	assert.Equal(t, []int{3, 3, 2, 2, 2, 2, 2, 3, 3, 3}, openPerDay)
+}
+
+func TestBuildStatsReportDefaultRange(t *testing.T) {
+	t.Parallel()
+
+	report, err := buildStatsReport(nil, time.Time{}, time.Time{}, statsNow)
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 8, 21, 0, 0, 0, 0, time.UTC), report.from)
+	assert.Equal(t, time.Date(2025, 9, 17, 0, 0, 0, 0, time.UTC), report.to)
+	assert.Len(t, report.burndown, defaultStatsDays)
+}
+
+func TestBuildStatsReportUsesClockLocation(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("UTC+10", 10*60*60)
+	tasks := []domain.Task{
+		// 2025-09-16 in UTC, but already 2025-09-17 in UTC+10.
+		{Id: 1, CurrentStatus: domain.Todo, CreatedAt: time.Date(2025, 9, 16, 20, 0, 0, 0, time.UTC)},
+	}
+
+	report, err := buildStatsReport(tasks,
+		time.Date(2025, 9, 16, 0, 0, 0, 0, loc), time.Date(2025, 9, 17, 0, 0, 0, 0, loc), statsNow.In(loc))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, report.burndown[0].open)
+	assert.Equal(t, 1, report.burndown[1].open)
+}
+
+func TestBuildStatsReportInvalidRange(t *testing.T) {
+	t.Parallel()
+
+	_, err := buildStatsReport(nil,
+		time.Date(2025, 9, 17, 0, 0, 0, 0, time.UTC), time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), statsNow)
+
+	assert.EqualError(t, err, fmt.Sprintf("invalid date range: %s is after %s", "2025-09-17", "2025-09-01"))
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestBuildStatsReportRangeLimit(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 9, 17, 0, 0, 0, 0, time.UTC)
+
+	report, err := buildStatsReport(nil, from, from.AddDate(0, 0, maxStatsDays-1), statsNow)
+	assert.NoError(t, err)
+	assert.Len(t, report.burndown, maxStatsDays)
+
+	_, err = buildStatsReport(nil, from, from.AddDate(0, 0, maxStatsDays), statsNow)
+	assert.EqualError(t, err, "invalid date range: 2024-09-17 to 2025-09-18 is longer than 366 days")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	_, err = buildStatsReport(nil, time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}, statsNow)
+	assert.ErrorIs(t, err, ErrInvalidInput, "an open range ends today")
+}
+
+func TestBuildUserStats(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, buildUserStats(statsFixtureTasks), "tasks without users have no user summary")
+
+	tasks := []domain.Task{
+		{Id: 1, CurrentStatus: domain.Todo, CreatedBy: "alice", Assignee: "bob"},
+		{Id: 2, CurrentStatus: domain.Done, CreatedBy: "alice", Assignee: "Alice"},
+		{Id: 3, CurrentStatus: domain.InProgress, CreatedBy: "Bob", Assignee: "bob"},
+		{Id: 4, CurrentStatus: domain.Todo, CreatedBy: "carol"},
+	}
+
+	assert.Equal(t, []userStats{
+		{name: "alice", statusCounts: map[domain.Status]int{domain.Done: 1}, created: 2},
+		{name: "bob", statusCounts: map[domain.Status]int{domain.Todo: 1, domain.InProgress: 1}, created: 1},
+		{name: "carol", statusCounts: map[domain.Status]int{}, created: 1},
+		{name: unassignedName, statusCounts: map[domain.Status]int{domain.Todo: 1}},
+	}, buildUserStats(tasks))
+
+	out := renderStatsReport(statsReport{statusCounts: map[domain.Status]int{}, users: buildUserStats(tasks)})
+	assert.Contains(t, out, "Tasks by user\n"+
+		"  User           Todo   In progress  Done   Created\n"+
+		"  alice          0      0            1      2\n")
+}
+
+func TestRenderBurndown(t *testing.T) {
+	t.Parallel()
+
+	out := renderBurndown([]burndownPoint{
+		{day: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), open: 4},
+		{day: time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC), open: 1},
+		{day: time.Date(2025, 9, 3, 0, 0, 0, 0, time.UTC), open: 0},
+	})
+
+	assert.Equal(t, ""+
+		"  2025-09-01 |"+strings.Repeat("#", burndownBarWidth)+" 4\n"+
+		"  2025-09-02 |"+strings.Repeat("#", burndownBarWidth/4)+" 1\n"+
+		"  2025-09-03 | 0\n", out)
+}
+
+func TestFormatLeadTime(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0m", formatLeadTime(-time.Hour))
+	assert.Equal(t, "45m", formatLeadTime(45*time.Minute))
+	assert.Equal(t, "5h", formatLeadTime(5*time.Hour+10*time.Minute))
+	assert.Equal(t, "2d 3h", formatLeadTime(51*time.Hour))
+}
+
+func TestGetStats(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	storage.EXPECT().Load().Return(statsFixtureTasks, nil).Times(1)
+
+	out, err := getStats(storage, time.Date(2025, 9, 15, 0, 0, 0, 0, time.UTC), time.Date(2025, 9, 17, 0, 0, 0, 0, time.UTC),
+		func() time.Time { return statsNow })
+
+	assert.NoError(t, err)
+	assert.Contains(t, out, "  done         2\n")
+	assert.Contains(t, out, "  2025-09-15   2        1        \n")
+	assert.Contains(t, out, "Average lead time: 1d 12h (2 completed tasks)\n")
+	assert.Contains(t, out, "  1   Old todo             todo         2025-08-20 09:00 (28d 9h old)\n")
+	assert.Contains(t, out, "  2025-09-17 |"+strings.Repeat("#", burndownBarWidth)+" 3\n")
+
+	storage.EXPECT().Load().Return(nil, assert.AnError).Times(1)
+
+	_, err = getStats(storage, time.Time{}, time.Time{}, func() time.Time { return statsNow })
+	assert.EqualError(t, err, assert.AnError.Error())
+}
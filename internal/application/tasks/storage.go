@@ -3,17 +3,141 @@ package tasks
 import (
 	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
 	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/files"
+	"time"
+)
+
+const (
+	stateFileName   = "state.json"
+	archiveFileName = "archive.json"
 )
 
 type taskFileStorage struct {
 }
 
+type taskFileArchive struct {
+}
+
+// storageState holds bookkeeping data that must survive independently of the task list.
+type storageState struct {
+	LastId int
+	// AutoArchiveAfter is the age (e.g. "30d") after which done tasks are archived on save, empty when disabled.
+	AutoArchiveAfter string `json:",omitempty"`
+}
+
 var defaultTaskStorage domain.TaskStorage = &taskFileStorage{}
 
+var defaultTaskArchive domain.TaskArchive = &taskFileArchive{}
+
+// TasksFilePath returns the path of the file holding the active tasks.
+func TasksFilePath() string {
+	return files.SaveFilePath()
+}
+
+// ArchiveFilePath returns the path of the file holding the archived tasks.
+func ArchiveFilePath() string {
+	return files.FilePath(archiveFileName)
+}
+
+// SetAutoArchivePolicy enables archiving of done tasks older than age on every save.
+// An empty age disables the policy.
+func SetAutoArchivePolicy(age string) error {
+	if age != "" {
+		if _, err := ParseAgeString(age); err != nil {
+			return err
+		}
+	}
+
+	state, err := files.GetFromNamedFile[storageState](stateFileName)
+
+	if err != nil {
+		return newStorageError(err)
+	}
+
+	state.AutoArchiveAfter = age
+	return newStorageError(files.SaveToNamedFile(stateFileName, state))
+}
+
+// Every error of the file storage is returned as a storageError so that callers can tell it from invalid input.
+// Save takes the IDs of the saved tasks for good and gives a UUID to the tasks of older files that have none.
 func (t *taskFileStorage) Save(tasks []domain.Task) error {
-	return files.SaveToFile(tasks)
+	state, err := files.GetFromNamedFile[storageState](stateFileName)
+
+	if err != nil {
+		return newStorageError(err)
+	}
+
+	previous, err := files.GetFromFile[[]domain.Task]()
+
+	if err != nil {
+		return newStorageError(err)
+	}
+
+	tasks = inUTC(tasks)
+	assignMissingUuids(tasks, newUuid)
+
+	// The IDs of the tasks saved so far are never handed out again, even once their task is deleted.
+	if lastId := getNextId(state.LastId, append(previous, tasks...)) - 1; lastId != state.LastId {
+		state.LastId = lastId
+
+		if err := files.SaveToNamedFile(stateFileName, state); err != nil {
+			return newStorageError(err)
+		}
+	}
+
+	if state.AutoArchiveAfter != "" {
+		olderThan, err := ParseAgeString(state.AutoArchiveAfter)
+
+		if err != nil {
+			return newStorageError(err)
+		}
+
+		tasks, _, err = moveToArchive(defaultTaskArchive, tasks, olderThan, time.Now)
+
+		if err != nil {
+			return newStorageError(err)
+		}
+	}
+
+	return newStorageError(files.SaveToFile(tasks))
 }
 
 func (t *taskFileStorage) Load() ([]domain.Task, error) {
-	return files.GetFromFile[[]domain.Task]()
+	tasks, err := files.GetFromFile[[]domain.Task]()
+	return tasks, newStorageError(err)
+}
+
+func (t *taskFileStorage) NextId() (int, error) {
+	state, err := files.GetFromNamedFile[storageState](stateFileName)
+
+	if err != nil {
+		return 0, newStorageError(err)
+	}
+
+	tasks, err := t.Load()
+
+	if err != nil {
+		return 0, err
+	}
+
+	archived, err := defaultTaskArchive.Load()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return getNextId(state.LastId, append(tasks, archived...)), nil
+}
+
+func (a *taskFileArchive) Save(tasks []domain.Task) error {
+	return newStorageError(files.SaveToNamedFile(archiveFileName, inUTC(tasks)))
+}
+
+func (a *taskFileArchive) Load() ([]domain.Task, error) {
+	tasks, err := files.GetFromNamedFile[[]domain.Task](archiveFileName)
+
+	if err == nil && tasks == nil {
+		tasks = make([]domain.Task, 0)
+	}
+
+	return tasks, newStorageError(err)
 }
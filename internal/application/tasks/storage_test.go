@@ -0,0 +1,82 @@
+package tasks
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/files"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func useStorageDir(t *testing.T) string {
+	dir := t.TempDir()
+	files.SetSaveDir(dir)
+	t.Cleanup(func() { files.SetSaveDir("") })
+	return dir
+}
+
+func TestFileStorageLoadDoesNotWrite(t *testing.T) {
+	dir := useStorageDir(t)
+	legacy := []byte(`[{"Id":1,"Description":"Buy milk","CurrentStatus":0}]`)
+	require.NoError(t, os.WriteFile(TasksFilePath(), legacy, 0644))
+
+	storage := &taskFileStorage{}
+
+	tasks, err := storage.Load()
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Empty(t, tasks[0].Uuid)
+
+	id, err := storage.NextId()
+	require.NoError(t, err)
+	assert.Equal(t, 2, id)
+
+	content, err := os.ReadFile(TasksFilePath())
+	require.NoError(t, err)
+	assert.Equal(t, legacy, content, "loading leaves an older file as it is")
+	assert.NoFileExists(t, filepath.Join(dir, stateFileName), "NextId does not take the ID")
+
+	require.NoError(t, storage.Save(tasks))
+
+	saved, err := storage.Load()
+	require.NoError(t, err)
+	assert.NotEmpty(t, saved[0].Uuid, "saving gives the task a UUID")
+}
+
+func TestFileStorageIds(t *testing.T) {
+	useStorageDir(t)
+
+	storage := &taskFileStorage{}
+	now := func() time.Time { return time.Date(2025, 9, 17, 12, 0, 0, 0, time.UTC) }
+	uuid := func() string { return "uuid" }
+
+	ctrl := gomock.NewController(t)
+	rejecting := mocks.NewMockEventPublisher(ctrl)
+	rejecting.EXPECT().Before(gomock.Any()).Return(assert.AnError).Times(1)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+	publisher.EXPECT().Before(gomock.Any()).Return(nil).AnyTimes()
+	publisher.EXPECT().Publish(gomock.Any()).AnyTimes()
+
+	_, err := addTask(storage, rejecting, "Rejected", "", now, uuid)
+	assert.ErrorIs(t, err, assert.AnError)
+
+	id, err := storage.NextId()
+	require.NoError(t, err)
+	assert.Equal(t, 1, id, "a rejected task does not take an ID")
+
+	first, err := addTask(storage, publisher, "Buy milk", "", now, uuid)
+	require.NoError(t, err)
+	second, err := addTask(storage, publisher, "Call mom", "", now, uuid)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, []int{first.Id, second.Id})
+
+	require.NoError(t, deleteTask(storage, publisher, second.Id, now))
+
+	id, err = storage.NextId()
+	require.NoError(t, err)
+	assert.Equal(t, 3, id, "the ID of a deleted task is not handed out again")
+}
@@ -0,0 +1,424 @@
+package tasks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/files"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/git"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const (
+	syncRemote        = "origin"
+	syncBranch        = "main"
+	tasksFileName     = "tasks.json"
+	gitignoreFileName = ".gitignore"
+	// configFileName is the configuration file, which is kept in the save directory unless storage.path moves it.
+	configFileName = "config.json"
+	// legacyWebhooksFileName held the webhooks and their secrets before they moved to the configuration.
+	legacyWebhooksFileName = "webhooks.json"
+)
+
+// syncIgnored lists the files of the save directory that stay local to each machine. Hook scripts run on
+// every change and the webhook settings hold secrets, sharing them would let a teammate run code on every
+// machine that syncs.
+var syncIgnored = []string{webhooksLogFileName, reminderLogFileName, legacyWebhooksFileName, hooksDirName + "/", configFileName}
+
+// SyncReport summarizes what a sync did.
+type SyncReport struct {
+	Initialized bool
+	Committed   bool
+	Pulled      bool
+	Merged      bool
+	Pushed      bool
+	Conflicts   []MergeConflict
+}
+
+// gitPublisher commits the save directory after every change once sync has been set up.
+type gitPublisher struct {
+	dir string
+}
+
+// SyncTasks commits local changes in the save directory, merges the changes from the remote and pushes
+// the result. The save directory becomes a git repository on the first sync. A non-empty remote
+// (a URL or path) replaces the configured one.
+func SyncTasks(remote string) (SyncReport, error) {
+	return syncTasks(git.Open(files.SaveDir()), remote)
+}
+
+func syncTasks(repo *git.Repository, remote string) (SyncReport, error) {
+	var report SyncReport
+
+	if !repo.IsRepository() {
+		if err := initSyncRepository(repo); err != nil {
+			return report, err
+		}
+
+		report.Initialized = true
+	}
+
+	if err := ignoreLocalFiles(repo); err != nil {
+		return report, err
+	}
+
+	if remote != "" {
+		if err := setSyncRemote(repo, remote); err != nil {
+			return report, err
+		}
+	}
+
+	if !repo.Succeeds("remote", "get-url", syncRemote) {
+		return report, newInvalidInputError("no remote configured, run sync with --remote <url> first")
+	}
+
+	message := "Update tasks"
+	if report.Initialized {
+		message = "Start syncing tasks"
+	}
+
+	committed, err := repo.CommitAll(message)
+
+	if err != nil {
+		return report, err
+	}
+
+	report.Committed = committed
+
+	branch, err := repo.Run("symbolic-ref", "--short", "HEAD")
+
+	if err != nil {
+		return report, err
+	}
+
+	if _, err := repo.Run("fetch", "--quiet", syncRemote); err != nil {
+		return report, err
+	}
+
+	remoteBranch := syncRemote + "/" + branch
+
+	if repo.Succeeds("rev-parse", "--verify", "--quiet", "refs/remotes/"+remoteBranch) {
+		if err := checkRemoteIgnored(repo, remoteBranch); err != nil {
+			return report, err
+		}
+
+		counts, err := repo.Run("rev-list", "--left-right", "--count", "HEAD..."+remoteBranch)
+
+		if err != nil {
+			return report, err
+		}
+
+		var ahead, behind int
+		if _, err := fmt.Sscan(counts, &ahead, &behind); err != nil {
+			return report, fmt.Errorf("unexpected output of git rev-list: %s", counts)
+		}
+
+		if behind == 0 && ahead == 0 {
+			return report, nil
+		}
+
+		if behind > 0 {
+			report.Pulled = true
+
+			if ahead == 0 {
+				if err := repo.Merge("--ff-only", remoteBranch); err != nil {
+					return report, err
+				}
+
+				return report, nil
+			}
+
+			conflicts, err := mergeSyncBranch(repo, remoteBranch)
+
+			if err != nil {
+				return report, err
+			}
+
+			report.Merged = true
+			report.Conflicts = conflicts
+		}
+	}
+
+	if _, err := repo.Run("push", "--quiet", "--set-upstream", syncRemote, branch); err != nil {
+		return report, err
+	}
+
+	report.Pushed = true
+	return report, nil
+}
+
+func initSyncRepository(repo *git.Repository) error {
+	return repo.Init(syncBranch)
+}
+
+// ignoreLocalFiles adds the local files missing from .gitignore, keeping the lines already there, and stops
+// tracking local files committed before they were ignored. It runs on every sync, so repositories set up
+// by older versions pick up files ignored since.
+func ignoreLocalFiles(repo *git.Repository) error {
+	content, err := os.ReadFile(filepath.Join(repo.Dir(), gitignoreFileName))
+
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	lines := strings.Split(strings.TrimRight(string(content), "\n"), "\n")
+	if len(content) == 0 {
+		lines = nil
+	}
+
+	changed := false
+
+	for _, name := range syncIgnored {
+		if !slices.Contains(lines, name) {
+			lines = append(lines, name)
+			changed = true
+		}
+	}
+
+	if changed {
+		if err := repo.WriteFile(gitignoreFileName, []byte(strings.Join(lines, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+
+	_, err = repo.Run(append([]string{"rm", "-r", "--cached", "--quiet", "--ignore-unmatch", "--"}, syncIgnored...)...)
+	return err
+}
+
+// checkRemoteIgnored refuses to pull a remote branch that tracks local files, e.g. a hook script added
+// with git add --force, which would otherwise run on this machine at the next change.
+func checkRemoteIgnored(repo *git.Repository, remoteBranch string) error {
+	tracked, err := repo.Run(append([]string{"ls-tree", "-r", "--name-only", remoteBranch, "--"}, syncIgnored...)...)
+
+	if err != nil {
+		return err
+	}
+
+	if tracked != "" {
+		return fmt.Errorf("%s contains files that are never synced: %s; remove them from the remote first",
+			remoteBranch, strings.ReplaceAll(tracked, "\n", ", "))
+	}
+
+	return nil
+}
+
+func setSyncRemote(repo *git.Repository, remote string) error {
+	if repo.Succeeds("remote", "get-url", syncRemote) {
+		_, err := repo.Run("remote", "set-url", syncRemote, remote)
+		return err
+	}
+
+	_, err := repo.Run("remote", "add", syncRemote, remote)
+	return err
+}
+
+// mergeSyncBranch merges the remote branch into the current one. The task store files are always
+// merged task by task, git only merges the remaining files.
+func mergeSyncBranch(repo *git.Repository, remoteBranch string) ([]MergeConflict, error) {
+	mergeErr := repo.Merge("--no-ff", "--no-commit", "--allow-unrelated-histories", remoteBranch)
+
+	if mergeErr != nil && !repo.Succeeds("rev-parse", "--verify", "--quiet", "MERGE_HEAD") {
+		return nil, mergeErr
+	}
+
+	base, _ := repo.Run("merge-base", "HEAD", "MERGE_HEAD")
+
+	var conflicts []MergeConflict
+	maxId := 0
+
+	for _, fileName := range []string{tasksFileName, archiveFileName} {
+		merged, fileConflicts, err := mergeTaskFile(repo, base, fileName)
+
+		if err != nil {
+			return nil, abortMerge(repo, err)
+		}
+
+		conflicts = append(conflicts, fileConflicts...)
+		maxId = max(maxId, maxTaskId(merged))
+	}
+
+	if err := mergeStateFile(repo, base, maxId); err != nil {
+		return nil, abortMerge(repo, err)
+	}
+
+	unmerged, err := repo.Run("diff", "--name-only", "--diff-filter=U")
+
+	if err != nil {
+		return nil, abortMerge(repo, err)
+	}
+
+	if unmerged != "" {
+		return nil, abortMerge(repo, fmt.Errorf("cannot merge %s automatically", strings.ReplaceAll(unmerged, "\n", ", ")))
+	}
+
+	if err := repo.Commit("--no-edit", "--message", "Merge tasks from "+remoteBranch); err != nil {
+		return nil, err
+	}
+
+	return conflicts, nil
+}
+
+func mergeTaskFile(repo *git.Repository, base, fileName string) ([]domain.Task, []MergeConflict, error) {
+	baseTasks, err := showTasks(repo, base, fileName)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ours, err := showTasks(repo, "HEAD", fileName)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	theirs, err := showTasks(repo, "MERGE_HEAD", fileName)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if ours == nil && theirs == nil {
+		return nil, nil, nil
+	}
+
+	merged, conflicts := MergeTasks(baseTasks, ours, theirs)
+
+	if err := writeMergedFile(repo, fileName, merged); err != nil {
+		return nil, nil, err
+	}
+
+	return merged, conflicts, nil
+}
+
+// mergeStateFile keeps the ID counter above every ID handed out on either side and in the merged files.
+func mergeStateFile(repo *git.Repository, base string, maxId int) error {
+	baseState, err := showJSON[storageState](repo, base, stateFileName)
+
+	if err != nil {
+		return err
+	}
+
+	ours, err := showJSON[storageState](repo, "HEAD", stateFileName)
+
+	if err != nil {
+		return err
+	}
+
+	theirs, err := showJSON[storageState](repo, "MERGE_HEAD", stateFileName)
+
+	if err != nil {
+		return err
+	}
+
+	merged := storageState{}
+	if ours != nil {
+		merged = *ours
+	}
+
+	if theirs != nil {
+		merged.LastId = max(merged.LastId, theirs.LastId)
+
+		if baseState == nil || merged.AutoArchiveAfter == baseState.AutoArchiveAfter {
+			merged.AutoArchiveAfter = theirs.AutoArchiveAfter
+		}
+	}
+
+	merged.LastId = max(merged.LastId, maxId)
+	return writeMergedFile(repo, stateFileName, merged)
+}
+
+func showTasks(repo *git.Repository, revision, fileName string) ([]domain.Task, error) {
+	tasks, err := showJSON[[]domain.Task](repo, revision, fileName)
+
+	if err != nil || tasks == nil {
+		return nil, err
+	}
+
+	return *tasks, nil
+}
+
+// showJSON decodes a file at a revision. It returns nil when the revision or the file does not exist.
+func showJSON[T any](repo *git.Repository, revision, fileName string) (*T, error) {
+	if revision == "" {
+		return nil, nil
+	}
+
+	content, err := repo.Show(revision, fileName)
+
+	if err != nil || content == nil {
+		return nil, err
+	}
+
+	value := new(T)
+
+	if len(content) == 0 {
+		return value, nil
+	}
+
+	if err := json.Unmarshal(content, value); err != nil {
+		return nil, fmt.Errorf("%s in %s is not valid: %w", fileName, revision, err)
+	}
+
+	return value, nil
+}
+
+func writeMergedFile[T any](repo *git.Repository, fileName string, data T) error {
+	content, err := json.MarshalIndent(data, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := repo.WriteFile(fileName, append(content, '\n')); err != nil {
+		return err
+	}
+
+	_, err = repo.Run("add", "--", fileName)
+	return err
+}
+
+func abortMerge(repo *git.Repository, err error) error {
+	_, _ = repo.Run("merge", "--abort")
+	return err
+}
+
+func (p *gitPublisher) Before(event domain.Event) error {
+	return nil
+}
+
+func (p *gitPublisher) Publish(event domain.Event) {
+	repo := git.Open(p.dir)
+
+	if !repo.IsRepository() {
+		return
+	}
+
+	if _, err := repo.CommitAll(commitMessage(event)); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Warning: could not commit the change: %s\n", err.Error())
+	}
+}
+
+// commitMessage describes a change for the commit recording it.
+func commitMessage(event domain.Event) string {
+	task := event.Task
+
+	switch event.Type {
+	case domain.TaskCreated:
+		return fmt.Sprintf("Add task %d: %s", task.Id, task.Description)
+	case domain.TaskUpdated:
+		return fmt.Sprintf("Update task %d: %s", task.Id, task.Description)
+	case domain.StatusChanged:
+		return fmt.Sprintf("Mark task %d as %s", task.Id, task.CurrentStatus.String())
+	case domain.TaskDeleted:
+		return fmt.Sprintf("Delete task %d: %s", task.Id, task.Description)
+	case domain.TaskArchived:
+		return fmt.Sprintf("Archive task %d: %s", task.Id, task.Description)
+	default:
+		return fmt.Sprintf("Change task %d", task.Id)
+	}
+}
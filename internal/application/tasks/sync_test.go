@@ -0,0 +1,231 @@
+package tasks
+
+import (
+	"encoding/json"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func requireGit(t *testing.T) {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+}
+
+func newBareRemote(t *testing.T) string {
+	t.Helper()
+
+	remote := filepath.Join(t.TempDir(), "remote.git")
+	require.NoError(t, exec.Command("git", "init", "--quiet", "--bare", remote).Run())
+
+	return remote
+}
+
+func writeTasksFile(t *testing.T, dir string, tasks []domain.Task) {
+	t.Helper()
+
+	data, err := json.Marshal(tasks)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, tasksFileName), data, 0644))
+}
+
+func readTasksFile(t *testing.T, dir string) []domain.Task {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(dir, tasksFileName))
+	require.NoError(t, err)
+
+	var tasks []domain.Task
+	require.NoError(t, json.Unmarshal(data, &tasks))
+
+	return tasks
+}
+
+func gitLog(t *testing.T, dir string) []string {
+	t.Helper()
+
+	log, err := git.Open(dir).Run("log", "--format=%s")
+	require.NoError(t, err)
+
+	return strings.Split(log, "\n")
+}
+
+func TestSyncTasks(t *testing.T) {
+	t.Parallel()
+	requireGit(t)
+
+	created := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+	report := domain.Task{Id: 1, Uuid: "uuid-1", Description: "Write report", CurrentStatus: domain.Todo, CreatedAt: created, UpdatedAt: created}
+	trip := domain.Task{Id: 1, Uuid: "uuid-2", Description: "Plan trip", CurrentStatus: domain.Todo, CreatedAt: created, UpdatedAt: created}
+
+	remote := newBareRemote(t)
+	laptop := filepath.Join(t.TempDir(), "laptop")
+	desktop := filepath.Join(t.TempDir(), "desktop")
+
+	writeTasksFile(t, laptop, []domain.Task{report})
+
+	result, err := syncTasks(git.Open(laptop), remote)
+	require.NoError(t, err)
+	assert.Equal(t, SyncReport{Initialized: true, Committed: true, Pushed: true}, result)
+
+	writeTasksFile(t, desktop, []domain.Task{trip})
+
+	result, err = syncTasks(git.Open(desktop), remote)
+	require.NoError(t, err)
+	assert.True(t, result.Merged)
+	assert.Equal(t, []MergeConflict{{TaskId: 1, Field: "id", Reason: "already taken, renumbered to 2"}}, result.Conflicts)
+
+	renumbered := report
+	renumbered.Id = 2
+	assert.Equal(t, []domain.Task{trip, renumbered}, readTasksFile(t, desktop))
+
+	result, err = syncTasks(git.Open(laptop), "")
+	require.NoError(t, err)
+	assert.Equal(t, SyncReport{Pulled: true}, result)
+	assert.Equal(t, []domain.Task{trip, renumbered}, readTasksFile(t, laptop))
+
+	// Concurrent edits of different fields of the same task.
+	laptopReport := renumbered
+	laptopReport.CurrentStatus = domain.Done
+	laptopReport.CompletedAt = created.Add(time.Hour)
+	laptopReport.UpdatedAt = created.Add(time.Hour)
+	writeTasksFile(t, laptop, []domain.Task{trip, laptopReport})
+
+	desktopReport := renumbered
+	desktopReport.Description = "Write the quarterly report"
+	desktopReport.UpdatedAt = created.Add(2 * time.Hour)
+	writeTasksFile(t, desktop, []domain.Task{trip, desktopReport})
+
+	result, err = syncTasks(git.Open(laptop), "")
+	require.NoError(t, err)
+	assert.Equal(t, SyncReport{Committed: true, Pushed: true}, result)
+
+	result, err = syncTasks(git.Open(desktop), "")
+	require.NoError(t, err)
+	assert.Equal(t, SyncReport{Committed: true, Pulled: true, Merged: true, Pushed: true}, result)
+
+	expected := desktopReport
+	expected.CurrentStatus = domain.Done
+	expected.CompletedAt = laptopReport.CompletedAt
+	assert.Equal(t, []domain.Task{trip, expected}, readTasksFile(t, desktop))
+
+	state, err := os.ReadFile(filepath.Join(desktop, stateFileName))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"LastId": 2}`, string(state))
+
+	assert.Equal(t, []string{"Merge tasks from origin/main", "Update tasks", "Update tasks", "Merge tasks from origin/main", "Start syncing tasks", "Start syncing tasks"}, gitLog(t, desktop))
+}
+
+func TestSyncTasksWithoutRemote(t *testing.T) {
+	t.Parallel()
+	requireGit(t)
+
+	dir := t.TempDir()
+
+	result, err := syncTasks(git.Open(dir), "")
+
+	assert.EqualError(t, err, "no remote configured, run sync with --remote <url> first")
+	assert.True(t, result.Initialized)
+
+	ignored, err := os.ReadFile(filepath.Join(dir, gitignoreFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "webhooks.log\nreminders.json\nwebhooks.json\nhooks/\nconfig.json\n", string(ignored))
+}
+
+func TestSyncTasksIgnoresLocalFiles(t *testing.T) {
+	t.Parallel()
+	requireGit(t)
+
+	remote := newBareRemote(t)
+	dir := t.TempDir()
+	repo := git.Open(dir)
+
+	// A repository set up before hooks and webhook settings were ignored.
+	require.NoError(t, repo.Init(syncBranch))
+	writeTasksFile(t, dir, []domain.Task{{Id: 1, Description: "Write report"}})
+	require.NoError(t, repo.WriteFile(gitignoreFileName, []byte("webhooks.log\n*.bak\n")))
+	require.NoError(t, repo.WriteFile(legacyWebhooksFileName, []byte(`[{"url": "https://example.com", "secret": "s3cret"}]`)))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, hooksDirName), 0755))
+	require.NoError(t, repo.WriteFile(filepath.Join(hooksDirName, "pre-add"), []byte("#!/bin/sh\n")))
+	_, err := repo.CommitAll("Start syncing tasks")
+	require.NoError(t, err)
+
+	_, err = syncTasks(repo, remote)
+	require.NoError(t, err)
+
+	ignored, err := os.ReadFile(filepath.Join(dir, gitignoreFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "webhooks.log\n*.bak\nreminders.json\nwebhooks.json\nhooks/\nconfig.json\n", string(ignored))
+
+	pushed, err := git.Open(remote).Run("ls-tree", "-r", "--name-only", syncBranch)
+	require.NoError(t, err)
+	assert.Equal(t, []string{gitignoreFileName, tasksFileName}, strings.Split(pushed, "\n"))
+	assert.FileExists(t, filepath.Join(dir, legacyWebhooksFileName))
+	assert.FileExists(t, filepath.Join(dir, hooksDirName, "pre-add"))
+
+	// A hook forced into the remote is not pulled.
+	other := t.TempDir()
+	_, err = git.Open(other).Run("clone", "--quiet", "--branch", syncBranch, remote, ".")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(other, hooksDirName), 0755))
+	require.NoError(t, git.Open(other).WriteFile(filepath.Join(hooksDirName, "pre-add"), []byte("#!/bin/sh\nrm -rf ~\n")))
+	_, err = git.Open(other).Run("add", "--force", hooksDirName)
+	require.NoError(t, err)
+	require.NoError(t, git.Open(other).Commit("--message", "Add hook"))
+	_, err = git.Open(other).Run("push", "--quiet", "origin", syncBranch)
+	require.NoError(t, err)
+
+	_, err = syncTasks(repo, "")
+	assert.EqualError(t, err, "origin/main contains files that are never synced: hooks/pre-add; remove them from the remote first")
+
+	hook, err := os.ReadFile(filepath.Join(dir, hooksDirName, "pre-add"))
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\n", string(hook))
+}
+
+func TestGitPublisher(t *testing.T) {
+	t.Parallel()
+	requireGit(t)
+
+	task := domain.Task{Id: 3, Description: "Buy milk", CurrentStatus: domain.Done}
+	dir := t.TempDir()
+	publisher := &gitPublisher{dir: dir}
+
+	// Nothing happens before sync has been set up.
+	publisher.Publish(domain.Event{Type: domain.TaskCreated, Task: task})
+	assert.NoDirExists(t, filepath.Join(dir, ".git"))
+
+	require.NoError(t, git.Open(dir).Init(syncBranch))
+
+	events := []domain.Event{
+		{Type: domain.TaskCreated, Task: task},
+		{Type: domain.TaskUpdated, Task: task},
+		{Type: domain.StatusChanged, Task: task},
+		{Type: domain.TaskDeleted, Task: task},
+		{Type: domain.TaskArchived, Task: task},
+	}
+
+	for i, event := range events {
+		writeTasksFile(t, dir, []domain.Task{{Id: i}})
+		publisher.Publish(event)
+	}
+
+	assert.Equal(t, []string{
+		"Archive task 3: Buy milk",
+		"Delete task 3: Buy milk",
+		"Mark task 3 as done",
+		"Update task 3: Buy milk",
+		"Add task 3: Buy milk",
+	}, gitLog(t, dir))
+}
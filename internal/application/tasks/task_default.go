@@ -1,27 +1,49 @@
 package tasks
 
 import (
-	"fmt"
 	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
-	"sort"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
 	"strings"
 	"time"
 )
 
+// taskRenderer colors the task tables, search results and the board.
+var taskRenderer = renderer.Plain()
+
+// SetRenderer changes how the task output is colored. A nil renderer turns colors off.
+func SetRenderer(r *renderer.Renderer) {
+	if r == nil {
+		r = renderer.Plain()
+	}
+
+	taskRenderer = r
+}
+
 func AddTask(description string) (domain.Task, error) {
-	return addTask(defaultTaskStorage, description, time.Now)
+	return addTask(defaultTaskStorage, defaultEventPublisher(), description, currentUser, time.Now, newUuid)
 }
 
 func UpdateTask(id int, description string) error {
-	return updateTask(defaultTaskStorage, id, description, time.Now)
+	return updateTask(defaultTaskStorage, defaultEventPublisher(), id, description, time.Now)
 }
 
 func UpdateTaskStatus(id int, status domain.Status) error {
-	return updateTaskStatus(defaultTaskStorage, id, status, time.Now)
+	return updateTaskStatus(defaultTaskStorage, defaultEventPublisher(), id, status, time.Now)
 }
 
 func DeleteTask(id int) error {
-	return deleteTask(defaultTaskStorage, id)
+	return deleteTask(defaultTaskStorage, defaultEventPublisher(), id, time.Now)
+}
+
+// ResolveTaskId turns a user supplied task reference into a task ID.
+// The reference can be either the numeric task ID or a unique prefix of the task UUID.
+func ResolveTaskId(ref string) (int, error) {
+	return resolveTaskId(defaultTaskStorage, ref)
+}
+
+// ListTasks returns all tasks for callers that render them on their own.
+func ListTasks() ([]domain.Task, error) {
+	return defaultTaskStorage.Load()
 }
 
 func GetAllTasks() (string, error) {
@@ -41,18 +63,47 @@ func ParseStatusString(statusStr string) (domain.Status, error) {
 	case domain.DoneStr:
 		return domain.Done, nil
 	default:
-		return 0, fmt.Errorf("invalid status string: %s", statusStr)
+		return 0, newInvalidInputError("invalid status string: %s", statusStr)
 	}
 }
 
-func getNextId(tasks []domain.Task) int {
-	if len(tasks) == 0 {
-		return 1
+func ParsePriorityString(priorityStr string) (domain.Priority, error) {
+	switch strings.ToLower(priorityStr) {
+	case domain.NoPriorityStr, "":
+		return domain.NoPriority, nil
+	case domain.LowPriorityStr:
+		return domain.LowPriority, nil
+	case domain.MediumPriorityStr:
+		return domain.MediumPriority, nil
+	case domain.HighPriorityStr:
+		return domain.HighPriority, nil
+	default:
+		return 0, newInvalidInputError("invalid priority string: %s", priorityStr)
+	}
+}
+
+// getNextId returns the ID following both the last issued ID and every ID present in tasks,
+// so stores created before the counter existed keep working.
+func getNextId(lastId int, tasks []domain.Task) int {
+	for _, task := range tasks {
+		if task.Id > lastId {
+			lastId = task.Id
+		}
 	}
 
-	sort.Slice(tasks, func(i, j int) bool {
-		return tasks[i].Id < tasks[j].Id
-	})
+	return lastId + 1
+}
+
+// assignMissingUuids gives a UUID to every task that has none and reports whether any task was changed.
+func assignMissingUuids(tasks []domain.Task, newUuid func() string) bool {
+	changed := false
+
+	for i := range tasks {
+		if tasks[i].Uuid == "" {
+			tasks[i].Uuid = newUuid()
+			changed = true
+		}
+	}
 
-	return tasks[len(tasks)-1].Id + 1
+	return changed
 }
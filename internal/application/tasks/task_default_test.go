@@ -1,4 +1,4 @@
-//go:generate mockgen -destination=mocks/storage.go -package=mocks  github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks TaskStorage
+//go:generate mockgen -destination=mocks/storage.go -package=mocks  github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks EventPublisher,Notifier,ReminderLog,TaskArchive,TaskStorage,TemplateStore
 package tasks
 
 import (
@@ -30,6 +30,7 @@ func TestAddTask(t *testing.T) {
 			name: "Successful Add",
 			addingTask: domain.Task{
 				Id:            1,
+				Uuid:          "3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f",
 				Description:   "New Task",
 				CurrentStatus: domain.Todo,
 				CreatedAt:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
@@ -41,7 +42,8 @@ func TestAddTask(t *testing.T) {
 				result := mocks.NewMockTaskStorage(ctrl)
 
 				firstCall := result.EXPECT().Load().Return([]domain.Task{}, nil).Times(1)
-				result.EXPECT().Save(gomock.Eq([]domain.Task{addingTask})).Times(1).After(firstCall)
+				secondCall := result.EXPECT().NextId().Return(1, nil).Times(1).After(firstCall)
+				result.EXPECT().Save(gomock.Eq([]domain.Task{addingTask})).Times(1).After(secondCall)
 
 				return result
 			},
@@ -72,7 +74,23 @@ func TestAddTask(t *testing.T) {
 				result := mocks.NewMockTaskStorage(ctrl)
 
 				firstCall := result.EXPECT().Load().Return([]domain.Task{}, nil).Times(1)
-				result.EXPECT().Save(gomock.Any()).Return(assert.AnError).Times(1).After(firstCall)
+				secondCall := result.EXPECT().NextId().Return(1, nil).Times(1).After(firstCall)
+				result.EXPECT().Save(gomock.Any()).Return(assert.AnError).Times(1).After(secondCall)
+
+				return result
+			},
+			expectedErr: assert.AnError,
+		},
+		{
+			name:       "Storage NextId Error",
+			addingTask: domain.Task{},
+			testStorageFn: func(t *testing.T, addingTask domain.Task) domain.TaskStorage {
+				t.Helper()
+
+				result := mocks.NewMockTaskStorage(ctrl)
+
+				firstCall := result.EXPECT().Load().Return([]domain.Task{}, nil).Times(1)
+				result.EXPECT().NextId().Return(0, assert.AnError).Times(1).After(firstCall)
 
 				return result
 			},
@@ -82,10 +100,13 @@ func TestAddTask(t *testing.T) {
 			name: "Add Task with Existing Tasks",
 			addingTask: domain.Task{
 				Id:            3,
+				Uuid:          "9b0e7d2a-51c4-4e8f-a3b6-0c1d2e3f4a5b",
 				Description:   "Another Task",
 				CurrentStatus: domain.Todo,
 				CreatedAt:     time.Date(2025, 2, 2, 15, 0, 0, 0, time.UTC),
 				UpdatedAt:     time.Date(2025, 2, 2, 15, 0, 0, 0, time.UTC),
+				CreatedBy:     "alice",
+				Assignee:      "alice",
 			},
 			testStorageFn: func(t *testing.T, addingTask domain.Task) domain.TaskStorage {
 				t.Helper()
@@ -97,7 +118,33 @@ func TestAddTask(t *testing.T) {
 
 				result := mocks.NewMockTaskStorage(ctrl)
 				firstCall := result.EXPECT().Load().Return(existingTasks, nil).Times(1)
-				result.EXPECT().Save(gomock.Eq(append(existingTasks, addingTask))).Times(1).After(firstCall)
+				secondCall := result.EXPECT().NextId().Return(3, nil).Times(1).After(firstCall)
+				result.EXPECT().Save(gomock.Eq(append(existingTasks, addingTask))).Times(1).After(secondCall)
+
+				return result
+			},
+		},
+		{
+			name: "Deleted Id Is Not Reused",
+			addingTask: domain.Task{
+				Id:            3,
+				Uuid:          "c4d5e6f7-0a1b-4c2d-8e3f-4a5b6c7d8e9f",
+				Description:   "Task After Delete",
+				CurrentStatus: domain.Todo,
+				CreatedAt:     time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC),
+				UpdatedAt:     time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC),
+			},
+			testStorageFn: func(t *testing.T, addingTask domain.Task) domain.TaskStorage {
+				t.Helper()
+
+				existingTasks := []domain.Task{
+					{Id: 1, Description: "Task 1", CurrentStatus: domain.Todo, CreatedAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), UpdatedAt: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)},
+				}
+
+				result := mocks.NewMockTaskStorage(ctrl)
+				firstCall := result.EXPECT().Load().Return(existingTasks, nil).Times(1)
+				secondCall := result.EXPECT().NextId().Return(3, nil).Times(1).After(firstCall)
+				result.EXPECT().Save(gomock.Eq(append(existingTasks, addingTask))).Times(1).After(secondCall)
 
 				return result
 			},
@@ -110,8 +157,14 @@ func TestAddTask(t *testing.T) {
 			t.Parallel()
 
 			taskStorage := tt.testStorageFn(t, tt.addingTask)
-			task, err := addTask(taskStorage, tt.addingTask.Description, func() time.Time {
+			publisher := mocks.NewMockEventPublisher(ctrl)
+			publisher.EXPECT().Before(gomock.Any()).AnyTimes()
+			publisher.EXPECT().Publish(gomock.Any()).AnyTimes()
+
+			task, err := addTask(taskStorage, publisher, tt.addingTask.Description, tt.addingTask.CreatedBy, func() time.Time {
 				return tt.addingTask.CreatedAt
+			}, func() string {
+				return tt.addingTask.Uuid
 			})
 
 			if tt.expectedErr != nil {
@@ -264,7 +317,11 @@ func TestUpdateTask(t *testing.T) {
 			t.Parallel()
 
 			taskStorage := tt.testStorageFn(t, tt.updatingTask)
-			err := updateTask(taskStorage, tt.updatingTask.Id, tt.updatingTask.Description, func() time.Time {
+			publisher := mocks.NewMockEventPublisher(ctrl)
+			publisher.EXPECT().Before(gomock.Any()).AnyTimes()
+			publisher.EXPECT().Publish(gomock.Any()).AnyTimes()
+
+			err := updateTask(taskStorage, publisher, tt.updatingTask.Id, tt.updatingTask.Description, func() time.Time {
 				return tt.updatingTask.UpdatedAt
 			})
 
@@ -375,6 +432,7 @@ func TestUpdateTaskStatus(t *testing.T) {
 				CurrentStatus: domain.Done,
 				CreatedAt:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
 				UpdatedAt:     time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC),
+				CompletedAt:   time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC),
 			},
 			testStorageFn: func(t *testing.T, updatingTask domain.Task) domain.TaskStorage {
 				t.Helper()
@@ -418,7 +476,11 @@ func TestUpdateTaskStatus(t *testing.T) {
 			t.Parallel()
 
 			taskStorage := tt.testStorageFn(t, tt.updatingTask)
-			err := updateTaskStatus(taskStorage, tt.updatingTask.Id, tt.updatingTask.CurrentStatus, func() time.Time {
+			publisher := mocks.NewMockEventPublisher(ctrl)
+			publisher.EXPECT().Before(gomock.Any()).AnyTimes()
+			publisher.EXPECT().Publish(gomock.Any()).AnyTimes()
+
+			err := updateTaskStatus(taskStorage, publisher, tt.updatingTask.Id, tt.updatingTask.CurrentStatus, func() time.Time {
 				return tt.updatingTask.UpdatedAt
 			})
 
@@ -538,7 +600,11 @@ func TestDeleteTask(t *testing.T) {
 			t.Parallel()
 
 			taskStorage := tt.testStorageFn(t, tt.taskID)
-			err := deleteTask(taskStorage, tt.taskID)
+			publisher := mocks.NewMockEventPublisher(ctrl)
+			publisher.EXPECT().Before(gomock.Any()).AnyTimes()
+			publisher.EXPECT().Publish(gomock.Any()).AnyTimes()
+
+			err := deleteTask(taskStorage, publisher, tt.taskID, time.Now)
 
 			if tt.expectedErr != nil {
 				assert.EqualError(t, err, tt.expectedErr.Error())
@@ -808,3 +874,153 @@ func TestParseStatusString(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNextId(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		lastId   int
+		tasks    []domain.Task
+		expected int
+	}
+
+	tests := []testCase{
+		{
+			name:     "Empty store",
+			expected: 1,
+		},
+		{
+			name:     "Counter ahead of tasks",
+			lastId:   5,
+			tasks:    []domain.Task{{Id: 1}, {Id: 2}},
+			expected: 6,
+		},
+		{
+			name:     "Tasks ahead of counter",
+			lastId:   0,
+			tasks:    []domain.Task{{Id: 4}, {Id: 2}},
+			expected: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, getNextId(tt.lastId, tt.tasks))
+		})
+	}
+}
+
+func TestAssignMissingUuids(t *testing.T) {
+	t.Parallel()
+
+	tasks := []domain.Task{
+		{Id: 1, Uuid: "3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f"},
+		{Id: 2},
+	}
+
+	changed := assignMissingUuids(tasks, func() string {
+		return "9b0e7d2a-51c4-4e8f-a3b6-0c1d2e3f4a5b"
+	})
+
+	assert.True(t, changed)
+	assert.Equal(t, "3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f", tasks[0].Uuid)
+	assert.Equal(t, "9b0e7d2a-51c4-4e8f-a3b6-0c1d2e3f4a5b", tasks[1].Uuid)
+	assert.False(t, assignMissingUuids(tasks, newUuid))
+}
+
+func TestResolveTaskId(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existingTasks := []domain.Task{
+		{Id: 1, Uuid: "3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f"},
+		{Id: 2, Uuid: "3f2b0000-51c4-4e8f-a3b6-0c1d2e3f4a5b"},
+		{Id: 12, Uuid: "2a000000-0000-4000-8000-000000000000"},
+		{Id: 20, Uuid: "19000000-0000-4000-8000-000000000000"},
+	}
+
+	type testCase struct {
+		name        string
+		ref         string
+		loadErr     error
+		expected    int
+		expectedErr error
+		expectedIs  error
+	}
+
+	tests := []testCase{
+		{
+			name:     "Numeric id",
+			ref:      "2",
+			expected: 2,
+		},
+		{
+			name:     "Unique uuid prefix",
+			ref:      "3f2a",
+			expected: 1,
+		},
+		{
+			name:     "Uuid prefix is case insensitive",
+			ref:      "3F2B",
+			expected: 2,
+		},
+		{
+			name:     "Ref with hex letters is a uuid prefix",
+			ref:      "2a",
+			expected: 12,
+		},
+		{
+			name:        "Numeric ref is never a uuid prefix",
+			ref:         "19",
+			expectedErr: fmt.Errorf("task with id [%s] not found", "19"),
+			expectedIs:  ErrTaskNotFound,
+		},
+		{
+			name:        "Ambiguous uuid prefix",
+			ref:         "3f2",
+			expectedErr: fmt.Errorf("task reference [%s] is ambiguous: it matches %d tasks", "3f2", 2),
+			expectedIs:  ErrInvalidID,
+		},
+		{
+			name:        "Unknown reference",
+			ref:         "7",
+			expectedErr: fmt.Errorf("task with id [%s] not found", "7"),
+			expectedIs:  ErrTaskNotFound,
+		},
+		{
+			name:        "Storage Load Error",
+			ref:         "1",
+			loadErr:     assert.AnError,
+			expectedErr: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := mocks.NewMockTaskStorage(ctrl)
+			storage.EXPECT().Load().Return(existingTasks, tt.loadErr).Times(1)
+
+			id, err := resolveTaskId(storage, tt.ref)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+
+				if tt.expectedIs != nil {
+					assert.ErrorIs(t, err, tt.expectedIs)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, id)
+			}
+		})
+	}
+}
@@ -3,66 +3,125 @@ package tasks
 import (
 	"fmt"
 	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
 
-func addTask(taskStorage domain.TaskStorage, description string, now func() time.Time) (domain.Task, error) {
+// taskRefPattern matches the task references worth looking up: an ID or the start of a UUID.
+var taskRefPattern = regexp.MustCompile(`^[0-9a-f][0-9a-f-]*$`)
+
+// addTask adds a task created by user, who is also its first assignee.
+func addTask(taskStorage domain.TaskStorage, publisher domain.EventPublisher, description, user string, now func() time.Time, newUuid func() string) (domain.Task, error) {
 	tasks, err := taskStorage.Load()
 
 	if err != nil {
 		return domain.Task{}, err
 	}
 
+	id, err := taskStorage.NextId()
+
+	if err != nil {
+		return domain.Task{}, err
+	}
+
 	newTask := domain.Task{
-		Id:            getNextId(tasks),
+		Id:            id,
+		Uuid:          newUuid(),
 		Description:   description,
 		CurrentStatus: domain.Todo,
 		CreatedAt:     now(),
 		UpdatedAt:     now(),
+		CreatedBy:     user,
+		Assignee:      user,
+	}
+
+	event := domain.Event{Type: domain.TaskCreated, Task: newTask, OccurredAt: now()}
+
+	if err := publisher.Before(event); err != nil {
+		return domain.Task{}, err
 	}
 
 	tasks = append(tasks, newTask)
-	return newTask, taskStorage.Save(tasks)
+
+	if err := taskStorage.Save(tasks); err != nil {
+		return newTask, err
+	}
+
+	publisher.Publish(event)
+	return newTask, nil
+}
+
+func updateTask(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id int, description string, now func() time.Time) error {
+	_, err := editTask(taskStorage, publisher, id, &description, nil, now)
+	return err
+}
+
+func updateTaskStatus(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id int, status domain.Status, now func() time.Time) error {
+	_, err := editTask(taskStorage, publisher, id, nil, &status, now)
+	return err
 }
 
-func updateTask(taskStorage domain.TaskStorage, id int, description string, now func() time.Time) error {
+// editTask changes the description and/or the status of a task with a single save and returns the changed task.
+// The change is published as StatusChanged when the status changes and as TaskUpdated when only the description
+// is given, an unchanged status alone publishes nothing.
+func editTask(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id int, description *string, status *domain.Status,
+	now func() time.Time) (domain.Task, error) {
 	tasks, err := taskStorage.Load()
 
 	if err != nil {
-		return err
+		return domain.Task{}, err
 	}
 
 	for i := range tasks {
-		if tasks[i].Id == id {
-			tasks[i].Description = description
-			tasks[i].UpdatedAt = now()
-			return taskStorage.Save(tasks)
+		if tasks[i].Id != id {
+			continue
 		}
-	}
 
-	return fmt.Errorf("task with id [%d] not found", id)
-}
+		previousStatus := tasks[i].CurrentStatus
 
-func updateTaskStatus(taskStorage domain.TaskStorage, id int, status domain.Status, now func() time.Time) error {
-	tasks, err := taskStorage.Load()
+		if description != nil {
+			tasks[i].Description = *description
+		}
 
-	if err != nil {
-		return err
-	}
+		if status != nil {
+			if *status == domain.Done && previousStatus != domain.Done {
+				tasks[i].CompletedAt = now()
+			} else if *status != domain.Done {
+				tasks[i].CompletedAt = time.Time{}
+			}
 
-	for i := range tasks {
-		if tasks[i].Id == id {
-			tasks[i].CurrentStatus = status
-			tasks[i].UpdatedAt = now()
-			return taskStorage.Save(tasks)
+			tasks[i].CurrentStatus = *status
+		}
+
+		tasks[i].UpdatedAt = now()
+		event := domain.Event{Type: domain.TaskUpdated, Task: tasks[i], OccurredAt: now()}
+
+		if tasks[i].CurrentStatus != previousStatus {
+			event.Type = domain.StatusChanged
+			event.PreviousStatus = previousStatus
+		} else if description == nil {
+			return tasks[i], taskStorage.Save(tasks)
 		}
+
+		if err := publisher.Before(event); err != nil {
+			return domain.Task{}, err
+		}
+
+		if err := taskStorage.Save(tasks); err != nil {
+			return domain.Task{}, err
+		}
+
+		publisher.Publish(event)
+		return tasks[i], nil
 	}
 
-	return fmt.Errorf("task with id [%d] not found", id)
+	return domain.Task{}, newTaskNotFoundError(id)
 }
 
-func deleteTask(taskStorage domain.TaskStorage, id int) error {
+func deleteTask(taskStorage domain.TaskStorage, publisher domain.EventPublisher, id int, now func() time.Time) error {
 	tasks, err := taskStorage.Load()
 
 	if err != nil {
@@ -71,32 +130,82 @@ func deleteTask(taskStorage domain.TaskStorage, id int) error {
 
 	for i := range tasks {
 		if tasks[i].Id == id {
+			event := domain.Event{Type: domain.TaskDeleted, Task: tasks[i], OccurredAt: now()}
+
+			if err := publisher.Before(event); err != nil {
+				return err
+			}
+
 			tasks = append(tasks[:i], tasks[i+1:]...)
-			return taskStorage.Save(tasks)
+
+			if err := taskStorage.Save(tasks); err != nil {
+				return err
+			}
+
+			publisher.Publish(event)
+			return nil
 		}
 	}
 
-	return fmt.Errorf("task with id [%d] not found", id)
+	return newTaskNotFoundError(id)
 }
 
-func getAllTasksList(storage domain.TaskStorage) (string, error) {
-	tasks, err := storage.Load()
+func resolveTaskId(taskStorage domain.TaskStorage, ref string) (int, error) {
+	ref = strings.ToLower(strings.TrimSpace(ref))
+
+	if ref == "" {
+		return 0, &invalidIdError{message: "task id is empty"}
+	}
+
+	if !taskRefPattern.MatchString(ref) {
+		return 0, &invalidIdError{message: fmt.Sprintf("invalid task reference [%s]: use a task ID or a UUID prefix", ref)}
+	}
+
+	tasks, err := taskStorage.Load()
 
 	if err != nil {
-		return "", err
+		return 0, err
 	}
 
-	var builder strings.Builder
-	builder.WriteString(getTaskListHeader())
+	// A numeric ref is always an ID. Matching it against UUIDs as well would let the ID of a deleted
+	// task silently pick another task whose UUID happens to start with the same digits.
+	if id, err := strconv.Atoi(ref); err == nil {
+		for _, task := range tasks {
+			if task.Id == id {
+				return id, nil
+			}
+		}
+
+		return 0, &taskNotFoundError{ref: ref}
+	}
+
+	var matches []domain.Task
 
 	for _, task := range tasks {
-		builder.WriteString(getTaskShortDescription(task))
+		if task.Uuid != "" && strings.HasPrefix(strings.ToLower(task.Uuid), ref) {
+			matches = append(matches, task)
+		}
 	}
 
-	return builder.String(), nil
+	switch len(matches) {
+	case 0:
+		return 0, &taskNotFoundError{ref: ref}
+	case 1:
+		return matches[0].Id, nil
+	default:
+		return 0, &invalidIdError{message: fmt.Sprintf("task reference [%s] is ambiguous: it matches %d tasks", ref, len(matches))}
+	}
+}
+
+func getAllTasksList(storage domain.TaskStorage) (string, error) {
+	return getMatchingTasksList(storage, func(domain.Task) bool { return true })
 }
 
 func getFilteredTasksList(storage domain.TaskStorage, status domain.Status) (string, error) {
+	return getMatchingTasksList(storage, func(task domain.Task) bool { return task.CurrentStatus == status })
+}
+
+func getMatchingTasksList(storage domain.TaskStorage, match func(task domain.Task) bool) (string, error) {
 	tasks, err := storage.Load()
 
 	if err != nil {
@@ -107,7 +216,7 @@ func getFilteredTasksList(storage domain.TaskStorage, status domain.Status) (str
 	builder.WriteString(getTaskListHeader())
 
 	for _, task := range tasks {
-		if task.CurrentStatus == status {
+		if match(task) {
 			builder.WriteString(getTaskShortDescription(task))
 		}
 	}
@@ -116,16 +225,18 @@ func getFilteredTasksList(storage domain.TaskStorage, status domain.Status) (str
 }
 
 func getTaskListHeader() string {
-	return fmt.Sprintf("%-3s %-20s %-12s %-16s %-16s\n",
-		"ID", "Description", "Status", "Created At", "Updated At")
+	return taskRenderer.Paint(renderer.Header, fmt.Sprintf("%-3s %-20s %-12s %-16s %-16s",
+		"ID", "Description", "Status", "Created At", "Updated At")) + "\n"
 }
 
+// getTaskShortDescription renders a task row. The cells are padded before they are colored
+// so that the escape sequences do not break the column alignment.
 func getTaskShortDescription(task domain.Task) string {
-	return fmt.Sprintf("%-3d %-20s %-12s %-16s %-16s\n",
+	return fmt.Sprintf("%-3d %s %s %-16s %-16s\n",
 		task.Id,
-		task.Description,
-		task.CurrentStatus.String(),
-		task.CreatedAt.Format("2006-01-02 15:04"),
-		task.UpdatedAt.Format("2006-01-02 15:04"),
+		taskRenderer.Task(task, fmt.Sprintf("%-20s", task.Description)),
+		taskRenderer.Status(task.CurrentStatus, fmt.Sprintf("%-12s", task.CurrentStatus.String())),
+		FormatDate(task.CreatedAt),
+		FormatDate(task.UpdatedAt),
 	)
 }
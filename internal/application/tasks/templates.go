@@ -0,0 +1,386 @@
+package tasks
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/files"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// templateDirName is the directory next to tasks.json holding one JSON file per template.
+const templateDirName = "templates"
+
+const templateFileExtension = ".json"
+
+var (
+	templateNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+	variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	placeholderPattern  = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+)
+
+type templateFileStore struct {
+}
+
+// templateDue is the due date of a template task relative to the moment the template is applied.
+// Days and weeks are calendar days, so that a task due in "3d" keeps its time of day across a
+// daylight saving change, the other units are elapsed time.
+type templateDue struct {
+	days     int
+	duration time.Duration
+}
+
+var defaultTemplateStore domain.TemplateStore = &templateFileStore{}
+
+// SaveTemplate stores the tasks tagged with fromTag as the template name. Every variable value found in their
+// descriptions and tags becomes a placeholder, e.g. version=1.3 turns "Release 1.3" into "Release {{version}}".
+func SaveTemplate(name, fromTag string, vars map[string]string) (domain.Template, error) {
+	return saveTemplate(defaultTaskStorage, defaultTemplateStore, name, fromTag, vars)
+}
+
+// ApplyTemplate creates the tasks of the template name with its placeholders replaced by vars.
+func ApplyTemplate(name string, vars map[string]string) ([]domain.Task, error) {
+	return applyTemplate(defaultTaskStorage, defaultEventPublisher(), defaultTemplateStore, name, vars, currentUser, time.Now, newUuid)
+}
+
+// ListTemplates returns the names of all templates in alphabetical order.
+func ListTemplates() ([]string, error) {
+	return defaultTemplateStore.List()
+}
+
+// DeleteTemplate removes the template name.
+func DeleteTemplate(name string) error {
+	name, err := checkTemplateName(name)
+
+	if err != nil {
+		return err
+	}
+
+	return defaultTemplateStore.Delete(name)
+}
+
+// ParseTemplateVars reads template variables given as name=value.
+func ParseTemplateVars(values []string) (map[string]string, error) {
+	vars := make(map[string]string, len(values))
+
+	for _, value := range values {
+		name, content, ok := strings.Cut(value, "=")
+
+		if !ok || !variableNamePattern.MatchString(name) {
+			return nil, newInvalidInputError("invalid template variable %q, expected name=value", value)
+		}
+
+		vars[name] = content
+	}
+
+	return vars, nil
+}
+
+// checkTemplateName returns the name in the form it is stored in, template names end up in file names.
+func checkTemplateName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if !templateNamePattern.MatchString(name) {
+		return "", newInvalidInputError("invalid template name %q, use letters, digits, - and _", name)
+	}
+
+	return name, nil
+}
+
+func saveTemplate(taskStorage domain.TaskStorage, templates domain.TemplateStore, name, fromTag string, vars map[string]string) (domain.Template, error) {
+	name, err := checkTemplateName(name)
+
+	if err != nil {
+		return domain.Template{}, err
+	}
+
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return domain.Template{}, err
+	}
+
+	tag := strings.ToLower(strings.TrimPrefix(fromTag, domain.TagPrefix))
+	template := domain.Template{Name: name}
+
+	for _, task := range tasks {
+		if !task.HasTag(tag) {
+			continue
+		}
+
+		entry := domain.TemplateTask{
+			Description: withPlaceholders(task.DescriptionWithoutTags(), vars),
+			Priority:    task.Priority,
+		}
+
+		// The tag selecting the tasks only marks the checklist, the created tasks do not carry it.
+		for _, taskTag := range task.Tags() {
+			if taskTag != tag {
+				entry.Tags = append(entry.Tags, withPlaceholders(taskTag, vars))
+			}
+		}
+
+		if !task.DueAt.IsZero() {
+			entry.Due = formatTemplateDue(task.DueAt.Sub(task.CreatedAt))
+		}
+
+		template.Tasks = append(template.Tasks, entry)
+	}
+
+	if len(template.Tasks) == 0 {
+		return domain.Template{}, newInvalidInputError("no tasks are tagged %s%s", domain.TagPrefix, tag)
+	}
+
+	return template, templates.Save(template)
+}
+
+// applyTemplate creates all tasks of a template at once for user, a rejected task cancels the whole template.
+func applyTemplate(taskStorage domain.TaskStorage, publisher domain.EventPublisher, templates domain.TemplateStore,
+	name string, vars map[string]string, user string, now func() time.Time, newUuid func() string) ([]domain.Task, error) {
+	name, err := checkTemplateName(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := templates.Load(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if missing := missingVariables(template, vars); len(missing) > 0 {
+		return nil, newInvalidInputError("template %q needs the variables %s, set them with --var %s=...",
+			name, strings.Join(missing, ", "), missing[0])
+	}
+
+	dues := make([]templateDue, len(template.Tasks))
+
+	for i, entry := range template.Tasks {
+		if entry.Due == "" {
+			continue
+		}
+
+		if dues[i], err = parseTemplateDue(entry.Due); err != nil {
+			return nil, fmt.Errorf("template %q: invalid due %q of %q", name, entry.Due, entry.Description)
+		}
+	}
+
+	tasks, err := taskStorage.Load()
+
+	if err != nil {
+		return nil, err
+	}
+
+	firstId, err := taskStorage.NextId()
+
+	if err != nil {
+		return nil, err
+	}
+
+	created := make([]domain.Task, 0, len(template.Tasks))
+	events := make([]domain.Event, 0, len(template.Tasks))
+
+	for i, entry := range template.Tasks {
+		description := fillPlaceholders(entry.Description, vars)
+		for _, tag := range entry.Tags {
+			description += " " + domain.TagPrefix + fillPlaceholders(strings.TrimPrefix(tag, domain.TagPrefix), vars)
+		}
+
+		task := domain.Task{
+			Id:            firstId + i,
+			Uuid:          newUuid(),
+			Description:   description,
+			CurrentStatus: domain.Todo,
+			Priority:      entry.Priority,
+			CreatedAt:     now(),
+			UpdatedAt:     now(),
+			CreatedBy:     user,
+			Assignee:      user,
+		}
+
+		if entry.Due != "" {
+			task.DueAt = dues[i].from(now())
+		}
+
+		event := domain.Event{Type: domain.TaskCreated, Task: task, OccurredAt: now()}
+
+		if err := publisher.Before(event); err != nil {
+			return nil, err
+		}
+
+		created = append(created, task)
+		events = append(events, event)
+	}
+
+	if err := taskStorage.Save(append(tasks, created...)); err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		publisher.Publish(event)
+	}
+
+	return created, nil
+}
+
+// withPlaceholders replaces the variable values in text by their placeholders, longer values first
+// so that a value containing another one wins.
+func withPlaceholders(text string, vars map[string]string) string {
+	names := make([]string, 0, len(vars))
+
+	for name, value := range vars {
+		if value != "" {
+			names = append(names, name)
+		}
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if len(vars[names[i]]) != len(vars[names[j]]) {
+			return len(vars[names[i]]) > len(vars[names[j]])
+		}
+
+		return names[i] < names[j]
+	})
+
+	for _, name := range names {
+		text = strings.ReplaceAll(text, vars[name], "{{"+name+"}}")
+	}
+
+	return text
+}
+
+func fillPlaceholders(text string, vars map[string]string) string {
+	return placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		return vars[placeholderPattern.FindStringSubmatch(placeholder)[1]]
+	})
+}
+
+// missingVariables returns the names of the placeholders of template without a value, in alphabetical order.
+func missingVariables(template domain.Template, vars map[string]string) []string {
+	missing := make(map[string]bool)
+
+	for _, entry := range template.Tasks {
+		for _, text := range append([]string{entry.Description}, entry.Tags...) {
+			for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+				if _, ok := vars[match[1]]; !ok {
+					missing[match[1]] = true
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(missing))
+	for name := range missing {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func parseTemplateDue(due string) (templateDue, error) {
+	due = strings.TrimSpace(strings.ToLower(due))
+
+	for suffix, days := range map[string]int{"d": 1, "w": 7} {
+		if number, ok := strings.CutSuffix(due, suffix); ok {
+			n, err := strconv.Atoi(number)
+
+			if err != nil || n < 0 {
+				return templateDue{}, newInvalidInputError("invalid age string: %s", due)
+			}
+
+			return templateDue{days: n * days}, nil
+		}
+	}
+
+	duration, err := ParseAgeString(due)
+	return templateDue{duration: duration}, err
+}
+
+func (d templateDue) from(t time.Time) time.Time {
+	return t.AddDate(0, 0, d.days).Add(d.duration)
+}
+
+// formatTemplateDue renders the time from creation to the due date of a task in the largest fitting unit.
+func formatTemplateDue(d time.Duration) string {
+	const day = 24 * time.Hour
+
+	switch {
+	case d <= 0:
+		return "0d"
+	case d >= day:
+		return fmt.Sprintf("%dd", (d+day/2)/day)
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", (d+time.Hour/2)/time.Hour)
+	default:
+		return fmt.Sprintf("%dm", (d+time.Minute/2)/time.Minute)
+	}
+}
+
+func templateFileName(name string) string {
+	return filepath.Join(templateDirName, name+templateFileExtension)
+}
+
+func (s *templateFileStore) Save(template domain.Template) error {
+	return newStorageError(files.SaveToNamedFile(templateFileName(template.Name), template))
+}
+
+func (s *templateFileStore) Load(name string) (domain.Template, error) {
+	exists, err := files.NamedFileExists(templateFileName(name))
+
+	if err != nil {
+		return domain.Template{}, newStorageError(err)
+	}
+
+	if !exists {
+		return domain.Template{}, &templateNotFoundError{name: name}
+	}
+
+	template, err := files.GetFromNamedFile[domain.Template](templateFileName(name))
+
+	if err != nil {
+		return domain.Template{}, newStorageError(err)
+	}
+
+	// Templates written by hand may leave out their name, the file name is the one that counts.
+	template.Name = name
+	return template, nil
+}
+
+func (s *templateFileStore) List() ([]string, error) {
+	fileNames, err := files.ListNamedFiles(templateDirName)
+
+	if err != nil {
+		return nil, newStorageError(err)
+	}
+
+	var names []string
+
+	for _, fileName := range fileNames {
+		if name, ok := strings.CutSuffix(fileName, templateFileExtension); ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+func (s *templateFileStore) Delete(name string) error {
+	exists, err := files.NamedFileExists(templateFileName(name))
+
+	if err != nil {
+		return newStorageError(err)
+	}
+
+	if !exists {
+		return &templateNotFoundError{name: name}
+	}
+
+	return newStorageError(files.RemoveNamedFile(templateFileName(name)))
+}
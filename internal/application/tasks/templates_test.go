@@ -0,0 +1,248 @@
+package tasks
+
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks/mocks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var releaseTemplate = domain.Template{
+	Name: "release",
+	Tasks: []domain.TemplateTask{
+		{Description: "Write changelog for {{version}}", Due: "2d"},
+		{Description: "Tag v{{version}}", Tags: []string{"release-{{version}}"}, Priority: domain.HighPriority, Due: "3d"},
+		{Description: "Announce the release", Tags: []string{"comms"}},
+	},
+}
+
+func TestParseTemplateVars(t *testing.T) {
+	t.Parallel()
+
+	vars, err := ParseTemplateVars([]string{"version=1.4", "codename=Blue Moon", "empty="})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"version": "1.4", "codename": "Blue Moon", "empty": ""}, vars)
+
+	_, err = ParseTemplateVars([]string{"version"})
+	assert.EqualError(t, err, `invalid template variable "version", expected name=value`)
+
+	_, err = ParseTemplateVars([]string{"1st=x"})
+	assert.EqualError(t, err, `invalid template variable "1st=x", expected name=value`)
+}
+
+func TestSaveTemplate(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	tasks := []domain.Task{
+		{Id: 1, Description: "Write changelog for 1.3 #release-checklist", CreatedAt: created, DueAt: created.Add(47 * time.Hour)},
+		{Id: 2, Description: "Buy milk", CreatedAt: created},
+		{Id: 3, Description: "Tag v1.3 #Release-Checklist #release-1.3", Priority: domain.HighPriority, CreatedAt: created, DueAt: created.AddDate(0, 0, 3)},
+		{Id: 4, Description: "Announce the release #comms #release-checklist", CreatedAt: created},
+	}
+
+	type testCase struct {
+		name        string
+		template    string
+		tag         string
+		vars        map[string]string
+		expected    domain.Template
+		expectedErr string
+	}
+
+	tests := []testCase{
+		{
+			name:     "Tasks of the tag become the template",
+			template: "Release",
+			tag:      "#release-checklist",
+			vars:     map[string]string{"version": "1.3", "major": "1"},
+			expected: releaseTemplate,
+		},
+		{
+			name:        "No tagged tasks",
+			template:    "release",
+			tag:         "missing",
+			expectedErr: "no tasks are tagged #missing",
+		},
+		{
+			name:        "Invalid name",
+			template:    "../release",
+			tag:         "release-checklist",
+			expectedErr: `invalid template name "../release", use letters, digits, - and _`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := mocks.NewMockTaskStorage(ctrl)
+			templates := mocks.NewMockTemplateStore(ctrl)
+
+			if tt.template != "../release" {
+				storage.EXPECT().Load().Return(tasks, nil).Times(1)
+			}
+
+			if tt.expectedErr == "" {
+				templates.EXPECT().Save(tt.expected).Return(nil).Times(1)
+			}
+
+			template, err := saveTemplate(storage, templates, tt.template, tt.tag, tt.vars)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, template)
+		})
+	}
+}
+
+func TestApplyTemplate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 9, 20, 9, 0, 0, 0, time.UTC)
+	existing := []domain.Task{{Id: 1, Description: "Buy milk"}}
+
+	storage := mocks.NewMockTaskStorage(ctrl)
+	publisher := mocks.NewMockEventPublisher(ctrl)
+	templates := mocks.NewMockTemplateStore(ctrl)
+
+	expected := []domain.Task{
+		{Id: 2, Uuid: "uuid", Description: "Write changelog for 1.4", CurrentStatus: domain.Todo, CreatedAt: now, UpdatedAt: now, CreatedBy: "alice", Assignee: "alice", DueAt: now.AddDate(0, 0, 2)},
+		{Id: 3, Uuid: "uuid", Description: "Tag v1.4 #release-1.4", CurrentStatus: domain.Todo, Priority: domain.HighPriority, CreatedAt: now, UpdatedAt: now, CreatedBy: "alice", Assignee: "alice", DueAt: now.AddDate(0, 0, 3)},
+		{Id: 4, Uuid: "uuid", Description: "Announce the release #comms", CurrentStatus: domain.Todo, CreatedAt: now, UpdatedAt: now, CreatedBy: "alice", Assignee: "alice"},
+	}
+
+	// Tags written by hand may keep their prefix.
+	template := releaseTemplate
+	template.Tasks = append([]domain.TemplateTask(nil), releaseTemplate.Tasks...)
+	template.Tasks[2].Tags = []string{"#comms"}
+
+	templates.EXPECT().Load("release").Return(template, nil).Times(1)
+	storage.EXPECT().Load().Return(existing, nil).Times(1)
+	storage.EXPECT().NextId().Return(2, nil).Times(1)
+
+	var publishes []*gomock.Call
+	for _, task := range expected {
+		event := domain.Event{Type: domain.TaskCreated, Task: task, OccurredAt: now}
+		publisher.EXPECT().Before(event).Return(nil).Times(1)
+		publishes = append(publishes, publisher.EXPECT().Publish(event).Times(1))
+	}
+
+	save := storage.EXPECT().Save(append(existing, expected...)).Return(nil).Times(1)
+	for _, publish := range publishes {
+		publish.After(save)
+	}
+
+	created, err := applyTemplate(storage, publisher, templates, "release", map[string]string{"version": "1.4"}, "alice",
+		func() time.Time { return now }, func() string { return "uuid" })
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, created)
+}
+
+func TestApplyTemplateErrors(t *testing.T) {
+	t.Parallel()
+
+	now := func() time.Time { return time.Date(2025, 9, 20, 9, 0, 0, 0, time.UTC) }
+	newUuid := func() string { return "uuid" }
+
+	type testCase struct {
+		name        string
+		template    domain.Template
+		loadErr     error
+		vars        map[string]string
+		expectedErr string
+		expectedIs  error
+	}
+
+	tests := []testCase{
+		{
+			name:        "Missing template",
+			loadErr:     &templateNotFoundError{name: "release"},
+			expectedErr: `template "release" not found`,
+			expectedIs:  ErrTemplateNotFound,
+		},
+		{
+			name:        "Missing variables",
+			template:    domain.Template{Name: "release", Tasks: []domain.TemplateTask{{Description: "Ship {{version}} {{ codename }}"}}},
+			expectedErr: `template "release" needs the variables codename, version, set them with --var codename=...`,
+		},
+		{
+			name:        "Invalid due",
+			template:    domain.Template{Name: "release", Tasks: []domain.TemplateTask{{Description: "Ship", Due: "soon"}}},
+			expectedErr: `template "release": invalid due "soon" of "Ship"`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			templates := mocks.NewMockTemplateStore(ctrl)
+			templates.EXPECT().Load("release").Return(tt.template, tt.loadErr).Times(1)
+
+			_, err := applyTemplate(mocks.NewMockTaskStorage(ctrl), mocks.NewMockEventPublisher(ctrl), templates,
+				"release", tt.vars, "", now, newUuid)
+
+			assert.EqualError(t, err, tt.expectedErr)
+
+			if tt.expectedIs != nil {
+				assert.ErrorIs(t, err, tt.expectedIs)
+			}
+		})
+	}
+}
+
+func TestTemplateDueAcrossDaylightSavingTime(t *testing.T) {
+	t.Parallel()
+
+	berlin := loadLocation(t, "Europe/Berlin")
+	// The clocks go back from 3:00 to 2:00 on Sunday, 2025-10-26.
+	now := time.Date(2025, 10, 24, 10, 0, 0, 0, berlin)
+
+	tests := []struct {
+		due      string
+		expected time.Time
+	}{
+		{due: "3d", expected: time.Date(2025, 10, 27, 10, 0, 0, 0, berlin)},
+		{due: "1w", expected: time.Date(2025, 10, 31, 10, 0, 0, 0, berlin)},
+		{due: "72h", expected: time.Date(2025, 10, 27, 9, 0, 0, 0, berlin)},
+	}
+
+	for _, tt := range tests {
+		due, err := parseTemplateDue(tt.due)
+
+		assert.NoError(t, err)
+		assert.True(t, tt.expected.Equal(due.from(now)), "%s: expected %s, got %s", tt.due, tt.expected, due.from(now))
+	}
+
+	_, err := parseTemplateDue("-1d")
+	assert.EqualError(t, err, "invalid age string: -1d")
+}
+
+func TestFormatTemplateDue(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0d", formatTemplateDue(-time.Hour))
+	assert.Equal(t, "45m", formatTemplateDue(45*time.Minute))
+	assert.Equal(t, "5h", formatTemplateDue(5*time.Hour+10*time.Minute))
+	assert.Equal(t, "2d", formatTemplateDue(47*time.Hour))
+	assert.Equal(t, "14d", formatTemplateDue(14*24*time.Hour))
+}
@@ -0,0 +1,425 @@
+// Package config reads and writes the task-cli configuration file.
+//
+// The file is JSON with one object per section, for example
+//
+//	{
+//	  "display": {"date_format": "02 Jan 15:04"},
+//	  "list": {"default_filter": "todo"}
+//	}
+//
+// Every setting can be overridden with an environment variable named TASK_CLI_ followed by the
+// key in upper case with dots replaced by underscores, e.g. TASK_CLI_DISPLAY_DATE_FORMAT.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/webhooks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
+)
+
+const (
+	StorageBackend    = "storage.backend"
+	StoragePath       = "storage.path"
+	DateFormat        = "display.date_format"
+	TimeZone          = "display.timezone"
+	RelativeDates     = "display.relative_dates"
+	Color             = "display.color"
+	DefaultListFilter = "list.default_filter"
+	BoardColumns      = "board.columns"
+	BoardWipLimits    = "board.wip_limits"
+	ReminderCommand   = "reminder.command"
+	UserName          = "user.name"
+	WebhookUrls       = "webhook.urls"
+	WebhookSecret     = "webhook.secret"
+	WebhookEvents     = "webhook.events"
+
+	// ThemePrefix starts the keys of the theme colors, e.g. theme.overdue.
+	ThemePrefix = "theme."
+
+	// PathEnv overrides the location of the configuration file.
+	PathEnv = "TASK_CLI_CONFIG"
+
+	envPrefix = "TASK_CLI_"
+	appName   = "TaskTracker-CLI"
+	fileName  = "config.json"
+)
+
+type Source int
+
+const (
+	FromDefault Source = iota
+	FromFile
+	FromEnvironment
+)
+
+func (s Source) String() string {
+	switch s {
+	case FromFile:
+		return "file"
+	case FromEnvironment:
+		return "environment"
+	default:
+		return "default"
+	}
+}
+
+// Config holds the values of the configuration file together with the environment overrides.
+type Config struct {
+	path   string
+	values map[string]string
+	lookup func(string) (string, bool)
+}
+
+// DefaultPath returns the location of the configuration file, $XDG_CONFIG_HOME/TaskTracker-CLI/config.json
+// on Linux and the matching user configuration directory on other systems.
+func DefaultPath() (string, error) {
+	if path := os.Getenv(PathEnv); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, appName, fileName), nil
+}
+
+// Load reads the configuration file at path. A missing file is an empty configuration.
+// When the file can not be read or parsed no Config is returned. When only some values are invalid
+// the returned Config is still usable, so that they can be fixed with Set, and the error describes
+// every invalid value.
+func Load(path string) (*Config, error) {
+	return load(path, os.LookupEnv)
+}
+
+func load(path string, lookup func(string) (string, bool)) (*Config, error) {
+	config := &Config{path: path, values: make(map[string]string), lookup: lookup}
+	content, err := os.ReadFile(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return config, config.Validate()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := config.parse(content); err != nil {
+		return nil, fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	return config, config.Validate()
+}
+
+// Keys returns all setting keys in alphabetical order.
+func Keys() []string {
+	keys := make([]string, 0, len(settings))
+
+	for key := range settings {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// Description returns the help text of a setting.
+func Description(key string) string {
+	return settings[key].description
+}
+
+// EnvName returns the environment variable that overrides key.
+func EnvName(key string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+func (c *Config) Path() string {
+	return c.path
+}
+
+// Get returns the effective value of key: the environment override, the file value or the default.
+func (c *Config) Get(key string) (string, error) {
+	value, _, err := c.Lookup(key)
+	return value, err
+}
+
+// Lookup returns the effective value of key and where it comes from.
+func (c *Config) Lookup(key string) (string, Source, error) {
+	setting, ok := settings[key]
+
+	if !ok {
+		return "", FromDefault, unknownKeyError(key)
+	}
+
+	if value, ok := c.lookup(EnvName(key)); ok {
+		return value, FromEnvironment, nil
+	}
+
+	if value, ok := c.values[key]; ok {
+		return value, FromFile, nil
+	}
+
+	return setting.defaultValue, FromDefault, nil
+}
+
+// Value returns the effective value of a known key, invalid values fall back to the default.
+func (c *Config) Value(key string) string {
+	value, _, err := c.Lookup(key)
+
+	if err != nil || settings[key].validate(value) != nil {
+		return settings[key].defaultValue
+	}
+
+	return value
+}
+
+// Theme returns the configured colors of the output elements.
+func (c *Config) Theme() renderer.Theme {
+	theme := renderer.Theme{}
+
+	for _, element := range renderer.Elements() {
+		theme[element] = c.Value(ThemePrefix + string(element))
+	}
+
+	return theme
+}
+
+// Location returns the configured time zone, the local zone when it is not set or invalid.
+func (c *Config) Location() *time.Location {
+	loc, err := time.LoadLocation(c.Value(TimeZone))
+
+	if err != nil {
+		return time.Local
+	}
+
+	return loc
+}
+
+// UserName returns the configured name of the user, the login name of the OS user when it is not set.
+func (c *Config) UserName() string {
+	if name := strings.TrimSpace(c.Value(UserName)); name != "" {
+		return name
+	}
+
+	return osUserName()
+}
+
+// WipLimits returns the configured maximum number of tasks per board column, keyed by status name.
+func (c *Config) WipLimits() map[string]int {
+	limits := make(map[string]int)
+
+	for _, item := range splitList(c.Value(BoardWipLimits)) {
+		column, value, _ := strings.Cut(item, "=")
+		limits[strings.TrimSpace(column)], _ = strconv.Atoi(strings.TrimSpace(value))
+	}
+
+	return limits
+}
+
+// Webhooks returns a receiver for each configured webhook URL, they share the secret and the events.
+func (c *Config) Webhooks() []webhooks.Webhook {
+	var events []domain.EventType
+
+	for _, event := range splitList(c.Value(WebhookEvents)) {
+		events = append(events, domain.EventType(event))
+	}
+
+	var configured []webhooks.Webhook
+
+	for _, url := range splitList(c.Value(WebhookUrls)) {
+		configured = append(configured, webhooks.Webhook{Url: url, Secret: c.Value(WebhookSecret), Events: events})
+	}
+
+	return configured
+}
+
+// osUserName returns the login name of the OS user, without the domain on Windows.
+func osUserName() string {
+	if current, err := user.Current(); err == nil && current.Username != "" {
+		_, name, found := strings.Cut(current.Username, `\`)
+		if !found {
+			name = current.Username
+		}
+
+		return name
+	}
+
+	for _, env := range []string{"USER", "USERNAME"} {
+		if name := os.Getenv(env); name != "" {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// StorageDir returns the configured storage directory with a leading ~ expanded, empty for the default.
+func (c *Config) StorageDir() string {
+	path := c.Value(StoragePath)
+
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+
+	return path
+}
+
+// Set validates value and stores it for key. An empty value removes the key from the file.
+func (c *Config) Set(key, value string) error {
+	setting, ok := settings[key]
+
+	if !ok {
+		return unknownKeyError(key)
+	}
+
+	if value == "" {
+		delete(c.values, key)
+		return nil
+	}
+
+	if err := setting.validate(value); err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	c.values[key] = value
+	return nil
+}
+
+// Validate checks every value set in the file or in the environment.
+func (c *Config) Validate() error {
+	var problems []string
+
+	for _, key := range Keys() {
+		value, source, _ := c.Lookup(key)
+
+		if source == FromDefault {
+			continue
+		}
+
+		if err := settings[key].validate(value); err != nil {
+			where := "config file " + c.path
+			if source == FromEnvironment {
+				where = "environment variable " + EnvName(key)
+			}
+
+			problems = append(problems, fmt.Sprintf("%s: %s: %s", where, key, err.Error()))
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "\n"))
+	}
+
+	return nil
+}
+
+// Save writes the file values to the configuration file. It holds the webhook secret, so only its owner may read it.
+func (c *Config) Save() error {
+	sections := make(map[string]map[string]string)
+
+	for key, value := range c.values {
+		section, name, _ := strings.Cut(key, ".")
+
+		if sections[section] == nil {
+			sections[section] = make(map[string]string)
+		}
+
+		sections[section][name] = value
+	}
+
+	content, err := json.MarshalIndent(sections, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(c.path, append(content, '\n'), 0600); err != nil {
+		return err
+	}
+
+	// WriteFile keeps the mode of an existing file, such as one written by an older version.
+	return os.Chmod(c.path, 0600)
+}
+
+func (c *Config) parse(content []byte) error {
+	if len(bytes.TrimSpace(content)) == 0 {
+		return nil
+	}
+
+	var sections map[string]json.RawMessage
+
+	if err := json.Unmarshal(content, &sections); err != nil {
+		return describeJSONError(content, err)
+	}
+
+	for section, raw := range sections {
+		var values map[string]json.RawMessage
+
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return fmt.Errorf("%s: must be an object", section)
+		}
+
+		for name, rawValue := range values {
+			key := section + "." + name
+
+			if _, ok := settings[key]; !ok {
+				return unknownKeyError(key)
+			}
+
+			var value string
+
+			if err := json.Unmarshal(rawValue, &value); err != nil {
+				return fmt.Errorf("%s: must be a string", key)
+			}
+
+			c.values[key] = value
+		}
+	}
+
+	return nil
+}
+
+// describeJSONError adds the line and column to syntax errors.
+func describeJSONError(content []byte, err error) error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	if errors.As(err, &typeErr) {
+		return errors.New("must be a JSON object")
+	}
+
+	if !errors.As(err, &syntaxErr) {
+		return err
+	}
+
+	before := content[:min(int(syntaxErr.Offset), len(content))]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := len(before) - bytes.LastIndexByte(before, '\n') - 1
+
+	return fmt.Errorf("line %d, column %d: %s", line, column, syntaxErr.Error())
+}
+
+func unknownKeyError(key string) error {
+	return fmt.Errorf("unknown setting %q, known settings are %s", key, strings.Join(Keys(), ", "))
+}
@@ -0,0 +1,381 @@
+package config
+
+import (
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/webhooks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+	return path
+}
+
+func environment(values map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := values[name]
+		return value, ok
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name          string
+		content       string
+		env           map[string]string
+		key           string
+		expected      string
+		expectedFrom  Source
+		expectedErr   string
+		expectedNoCfg bool
+	}
+
+	tests := []testCase{
+		{
+			name:         "Default value",
+			content:      `{}`,
+			key:          DateFormat,
+			expected:     "2006-01-02 15:04",
+			expectedFrom: FromDefault,
+		},
+		{
+			name:         "Empty file",
+			content:      "",
+			key:          Color,
+			expected:     "auto",
+			expectedFrom: FromDefault,
+		},
+		{
+			name:         "Value from file",
+			content:      `{"display": {"date_format": "02 Jan 15:04"}}`,
+			key:          DateFormat,
+			expected:     "02 Jan 15:04",
+			expectedFrom: FromFile,
+		},
+		{
+			name:         "Environment overrides file",
+			content:      `{"list": {"default_filter": "todo"}}`,
+			env:          map[string]string{"TASK_CLI_LIST_DEFAULT_FILTER": "done"},
+			key:          DefaultListFilter,
+			expected:     "done",
+			expectedFrom: FromEnvironment,
+		},
+		{
+			name:         "Invalid value in file",
+			content:      `{"display": {"color": "blue"}}`,
+			key:          Color,
+			expected:     "blue",
+			expectedFrom: FromFile,
+			expectedErr:  `config file %s: display.color: invalid value "blue", expected one of: auto, always, never`,
+		},
+		{
+			name:         "Invalid value in environment",
+			content:      `{}`,
+			env:          map[string]string{"TASK_CLI_BOARD_COLUMNS": "todo,blocked"},
+			key:          BoardColumns,
+			expected:     "todo,blocked",
+			expectedFrom: FromEnvironment,
+			expectedErr:  `environment variable TASK_CLI_BOARD_COLUMNS: board.columns: invalid status "blocked" in "todo,blocked", expected todo, in-progress, done`,
+		},
+		{
+			name:          "Syntax error",
+			content:       "{\n  \"display\": {\n    \"color\": \"auto\",\n  }\n}",
+			expectedErr:   `config file %s: line 4, column 3: invalid character '}' looking for beginning of object key string`,
+			expectedNoCfg: true,
+		},
+		{
+			name:          "Unknown setting",
+			content:       `{"display": {"colour": "auto"}}`,
+			expectedErr:   `config file %s: unknown setting "display.colour", known settings are board.columns, board.wip_limits, display.color, display.date_format, display.relative_dates, display.timezone, list.default_filter, reminder.command, storage.backend, storage.path, theme.done, theme.header, theme.highlight, theme.in_progress, theme.overdue, theme.priority_high, theme.priority_low, theme.priority_medium, theme.todo, theme.warning, user.name, webhook.events, webhook.secret, webhook.urls`,
+			expectedNoCfg: true,
+		},
+		{
+			name:          "Value is not a string",
+			content:       `{"display": {"color": true}}`,
+			expectedErr:   `config file %s: display.color: must be a string`,
+			expectedNoCfg: true,
+		},
+		{
+			name:          "Section is not an object",
+			content:       `{"display": "auto"}`,
+			expectedErr:   `config file %s: display: must be an object`,
+			expectedNoCfg: true,
+		},
+		{
+			name:          "File is not an object",
+			content:       `["display"]`,
+			expectedErr:   `config file %s: must be a JSON object`,
+			expectedNoCfg: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := writeConfig(t, tt.content)
+			config, err := load(path, environment(tt.env))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, strings.ReplaceAll(tt.expectedErr, "%s", path))
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if tt.expectedNoCfg {
+				assert.Nil(t, config)
+				return
+			}
+
+			value, source, err := config.Lookup(tt.key)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+			assert.Equal(t, tt.expectedFrom, source)
+		})
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "missing", "config.json")
+	config, err := load(path, environment(nil))
+
+	require.NoError(t, err)
+	assert.Equal(t, path, config.Path())
+	assert.Equal(t, "all", config.Value(DefaultListFilter))
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		key         string
+		value       string
+		expectedErr string
+	}
+
+	tests := []testCase{
+		{name: "Valid value", key: DefaultListFilter, value: "todo"},
+		{name: "Unknown key", key: "list.filter", value: "todo",
+			expectedErr: `unknown setting "list.filter", known settings are board.columns, board.wip_limits, display.color, display.date_format, display.relative_dates, display.timezone, list.default_filter, reminder.command, storage.backend, storage.path, theme.done, theme.header, theme.highlight, theme.in_progress, theme.overdue, theme.priority_high, theme.priority_low, theme.priority_medium, theme.todo, theme.warning, user.name, webhook.events, webhook.secret, webhook.urls`},
+		{name: "Invalid status", key: DefaultListFilter, value: "blocked",
+			expectedErr: `list.default_filter: invalid value "blocked", expected one of: all, todo, in-progress, done`},
+		{name: "Layout without date elements", key: DateFormat, value: "today",
+			expectedErr: `display.date_format: invalid value "today", expected a Go time layout such as "2006-01-02 15:04"`},
+		{name: "Relative storage path", key: StoragePath, value: "tasks",
+			expectedErr: `storage.path: invalid value "tasks", expected an absolute path`},
+		{name: "Duplicate column", key: BoardColumns, value: "todo,done,todo",
+			expectedErr: `board.columns: status "todo" is listed twice in "todo,done,todo"`},
+		{name: "Unsupported backend", key: StorageBackend, value: "sqlite",
+			expectedErr: `storage.backend: invalid value "sqlite", expected one of: json`},
+		{name: "WIP limit of an unknown column", key: BoardWipLimits, value: "in-progress=3,blocked=1",
+			expectedErr: `board.wip_limits: invalid limit "blocked=1", expected <status>=<number> with a status of todo, in-progress, done`},
+		{name: "Negative WIP limit", key: BoardWipLimits, value: "todo=-1",
+			expectedErr: `board.wip_limits: invalid limit "todo=-1", expected a non-negative number of tasks`},
+		{name: "Webhook URL without scheme", key: WebhookUrls, value: "https://a.example.com, example.com/hook",
+			expectedErr: `webhook.urls: invalid URL "example.com/hook", expected an http or https URL`},
+		{name: "Unknown webhook event", key: WebhookEvents, value: "task.created,task.closed",
+			expectedErr: `webhook.events: invalid event "task.closed", expected task.created, task.updated, task.status_changed, task.deleted, task.archived`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config, err := load(filepath.Join(t.TempDir(), "config.json"), environment(nil))
+			require.NoError(t, err)
+
+			err = config.Set(tt.key, tt.value)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.value, config.Value(tt.key))
+		})
+	}
+}
+
+func TestSaveRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", "config.json")
+	config, err := load(path, environment(nil))
+	require.NoError(t, err)
+
+	require.NoError(t, config.Set(DateFormat, "02 Jan 15:04"))
+	require.NoError(t, config.Set(Color, "never"))
+	require.NoError(t, config.Set(BoardColumns, "in-progress,todo"))
+	require.NoError(t, config.Set(BoardColumns, ""))
+	require.NoError(t, config.Save())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"display": {"color": "never", "date_format": "02 Jan 15:04"}}`, string(content))
+
+	reloaded, err := load(path, environment(nil))
+	require.NoError(t, err)
+	assert.Equal(t, "never", reloaded.Value(Color))
+	assert.Equal(t, "todo,in-progress,done", reloaded.Value(BoardColumns))
+}
+
+func TestSavePermissions(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows has no permission bits")
+	}
+
+	dir := filepath.Join(t.TempDir(), "task-cli")
+	path := filepath.Join(dir, "config.json")
+
+	config, err := load(path, environment(nil))
+	require.NoError(t, err)
+	require.NoError(t, config.Set(WebhookSecret, "change-me"))
+	require.NoError(t, config.Save())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	info, err = os.Stat(dir)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+
+	// A file written with the old permissions is tightened.
+	require.NoError(t, os.Chmod(path, 0644))
+	require.NoError(t, config.Save())
+
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestValueFallsBackToDefault(t *testing.T) {
+	t.Parallel()
+
+	config, _ := load(writeConfig(t, `{"display": {"color": "blue"}}`), environment(nil))
+
+	assert.Equal(t, "auto", config.Value(Color))
+}
+
+func TestTheme(t *testing.T) {
+	t.Parallel()
+
+	config, err := load(writeConfig(t, `{"theme": {"overdue": "reverse bright-red", "todo": "none"}}`),
+		environment(map[string]string{"TASK_CLI_THEME_DONE": "blue"}))
+	require.NoError(t, err)
+
+	theme := config.Theme()
+	assert.Equal(t, "reverse bright-red", theme[renderer.Overdue])
+	assert.Equal(t, "none", theme[renderer.Todo])
+	assert.Equal(t, "blue", theme[renderer.Done])
+	assert.Equal(t, "bold", theme[renderer.Header])
+
+	_, err = load(writeConfig(t, `{"theme": {"done": "sparkly"}}`), environment(nil))
+	assert.ErrorContains(t, err, `theme.done: invalid value "sparkly": unknown style "sparkly"`)
+}
+
+func TestLocation(t *testing.T) {
+	t.Parallel()
+
+	config, err := load(writeConfig(t, `{"display": {"timezone": "Europe/Berlin"}}`), environment(nil))
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", config.Location().String())
+
+	config, err = load(writeConfig(t, `{}`), environment(map[string]string{"TASK_CLI_DISPLAY_TIMEZONE": "UTC"}))
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, config.Location())
+
+	config, err = load(writeConfig(t, `{"display": {"timezone": "Mars/Olympus"}}`), environment(nil))
+	assert.ErrorContains(t, err, `display.timezone: invalid value "Mars/Olympus", expected a time zone such as "Europe/Berlin", "UTC" or "Local"`)
+	assert.Equal(t, time.Local, config.Location())
+}
+
+func TestUserName(t *testing.T) {
+	t.Parallel()
+
+	config, err := load(writeConfig(t, `{"user": {"name": "alice"}}`), environment(nil))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", config.UserName())
+
+	config, err = load(writeConfig(t, `{}`), environment(map[string]string{"TASK_CLI_USER_NAME": "bob"}))
+	require.NoError(t, err)
+	assert.Equal(t, "bob", config.UserName())
+
+	config, err = load(writeConfig(t, `{}`), environment(nil))
+	require.NoError(t, err)
+	assert.Equal(t, osUserName(), config.UserName())
+}
+
+func TestWipLimits(t *testing.T) {
+	t.Parallel()
+
+	config, err := load(writeConfig(t, `{"board": {"wip_limits": "in-progress=3, todo = 10"}}`), environment(nil))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"in-progress": 3, "todo": 10}, config.WipLimits())
+
+	config, err = load(writeConfig(t, `{}`), environment(nil))
+	require.NoError(t, err)
+	assert.Empty(t, config.WipLimits())
+}
+
+func TestWebhooks(t *testing.T) {
+	t.Parallel()
+
+	config, err := load(writeConfig(t, `{"webhook": {"urls": "https://a.example.com/hook, http://localhost:9000", "events": "task.created"}}`),
+		environment(map[string]string{"TASK_CLI_WEBHOOK_SECRET": "s3cret"}))
+	require.NoError(t, err)
+
+	assert.Equal(t, []webhooks.Webhook{
+		{Url: "https://a.example.com/hook", Secret: "s3cret", Events: []domain.EventType{domain.TaskCreated}},
+		{Url: "http://localhost:9000", Secret: "s3cret", Events: []domain.EventType{domain.TaskCreated}},
+	}, config.Webhooks())
+
+	config, err = load(writeConfig(t, `{}`), environment(nil))
+	require.NoError(t, err)
+	assert.Empty(t, config.Webhooks())
+}
+
+func TestStorageDir(t *testing.T) {
+	t.Parallel()
+
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	config, err := load(writeConfig(t, `{"storage": {"path": "~/tasks"}}`), environment(nil))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, "tasks"), config.StorageDir())
+
+	config, err = load(writeConfig(t, `{}`), environment(map[string]string{"TASK_CLI_STORAGE_PATH": "/srv/tasks"}))
+	require.NoError(t, err)
+	assert.Equal(t, "/srv/tasks", config.StorageDir())
+}
+
+func TestEnvName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "TASK_CLI_DISPLAY_DATE_FORMAT", EnvName(DateFormat))
+	assert.Equal(t, "TASK_CLI_STORAGE_PATH", EnvName(StoragePath))
+}
@@ -0,0 +1,244 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/renderer"
+)
+
+type setting struct {
+	description  string
+	defaultValue string
+	validate     func(value string) error
+}
+
+var statusNames = []string{domain.TodoStr, domain.InProgressStr, domain.DoneStr}
+
+var eventNames = []string{string(domain.TaskCreated), string(domain.TaskUpdated), string(domain.StatusChanged), string(domain.TaskDeleted),
+	string(domain.TaskArchived)}
+
+var settings = map[string]setting{
+	StorageBackend: {
+		description:  "Storage backend for the tasks",
+		defaultValue: "json",
+		validate:     oneOf("json"),
+	},
+	StoragePath: {
+		description:  "Directory holding tasks.json and the other task files (default: the configuration directory)",
+		defaultValue: "",
+		validate:     absolutePath,
+	},
+	DateFormat: {
+		description:  "Go time layout used to show dates, e.g. \"02 Jan 2006 15:04\"",
+		defaultValue: "2006-01-02 15:04",
+		validate:     dateLayout,
+	},
+	TimeZone: {
+		description:  "Time zone the dates are shown in and days are counted in, e.g. \"Europe/Berlin\", \"UTC\" or \"Local\"",
+		defaultValue: "Local",
+		validate:     timeZone,
+	},
+	RelativeDates: {
+		description:  "Show dates of the last four weeks relative to now, e.g. \"3h ago\": true or false",
+		defaultValue: "false",
+		validate:     oneOf("true", "false"),
+	},
+	Color: {
+		description:  "When to use colors: auto, always or never",
+		defaultValue: "auto",
+		validate:     oneOf("auto", "always", "never"),
+	},
+	DefaultListFilter: {
+		description:  "Status shown by \"list\" without arguments: all, todo, in-progress or done",
+		defaultValue: "all",
+		validate:     oneOf(append([]string{"all"}, statusNames...)...),
+	},
+	BoardColumns: {
+		description:  "Comma separated statuses shown as board columns, in order",
+		defaultValue: strings.Join(statusNames, ","),
+		validate:     statusList,
+	},
+	BoardWipLimits: {
+		description:  "Comma separated maximum numbers of tasks per board column, e.g. \"in-progress=3\" (default: no limits)",
+		defaultValue: "",
+		validate:     wipLimits,
+	},
+	ReminderCommand: {
+		description:  "Shell command run by the daemon for a reminder with the title and message as $1 and $2 (default: notify-send)",
+		defaultValue: "",
+		validate:     anyValue,
+	},
+	UserName: {
+		description:  "Name recorded as creator and assignee of new tasks and matched by \"list --mine\" (default: the OS user)",
+		defaultValue: "",
+		validate:     anyValue,
+	},
+	WebhookUrls: {
+		description:  "Comma separated http or https URLs every task event is posted to",
+		defaultValue: "",
+		validate:     urlList,
+	},
+	WebhookSecret: {
+		description:  "Secret signing the webhook requests with HMAC-SHA256 (default: unsigned)",
+		defaultValue: "",
+		validate:     anyValue,
+	},
+	WebhookEvents: {
+		description:  "Comma separated events sent to the webhooks (default: all): " + strings.Join(eventNames, ", "),
+		defaultValue: "",
+		validate:     eventList,
+	},
+}
+
+var themeDescriptions = map[renderer.Element]string{
+	renderer.Header:         "table headers",
+	renderer.Todo:           "the todo status",
+	renderer.InProgress:     "the in-progress status",
+	renderer.Done:           "the done status",
+	renderer.Overdue:        "tasks past their due date",
+	renderer.PriorityHigh:   "high priority tasks",
+	renderer.PriorityMedium: "medium priority tasks",
+	renderer.PriorityLow:    "low priority tasks",
+	renderer.Highlight:      "search matches",
+	renderer.Warning:        "warnings such as exceeded WIP limits",
+}
+
+func init() {
+	defaults := renderer.DefaultTheme()
+
+	for _, element := range renderer.Elements() {
+		settings[ThemePrefix+string(element)] = setting{
+			description:  fmt.Sprintf("Style of %s, e.g. \"bold red\" or \"none\"", themeDescriptions[element]),
+			defaultValue: defaults[element],
+			validate:     style,
+		}
+	}
+}
+
+func oneOf(allowed ...string) func(string) error {
+	return func(value string) error {
+		if slices.Contains(allowed, value) {
+			return nil
+		}
+
+		return fmt.Errorf("invalid value %q, expected one of: %s", value, strings.Join(allowed, ", "))
+	}
+}
+
+func anyValue(string) error {
+	return nil
+}
+
+func absolutePath(value string) error {
+	if value == "" || filepath.IsAbs(value) || strings.HasPrefix(value, "~/") {
+		return nil
+	}
+
+	return fmt.Errorf("invalid value %q, expected an absolute path", value)
+}
+
+// dateLayout rejects layouts without any reference date element, they would print the same text for every date.
+func dateLayout(value string) error {
+	reference := time.Date(2001, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	if strings.TrimSpace(value) == "" || reference.Format(value) == value {
+		return fmt.Errorf("invalid value %q, expected a Go time layout such as \"2006-01-02 15:04\"", value)
+	}
+
+	return nil
+}
+
+func timeZone(value string) error {
+	if _, err := time.LoadLocation(value); err != nil || value == "" {
+		return fmt.Errorf("invalid value %q, expected a time zone such as \"Europe/Berlin\", \"UTC\" or \"Local\"", value)
+	}
+
+	return nil
+}
+
+func style(value string) error {
+	if _, err := renderer.ParseStyle(value); err != nil {
+		return fmt.Errorf("invalid value %q: %w", value, err)
+	}
+
+	return nil
+}
+
+func statusList(value string) error {
+	seen := make(map[string]bool)
+
+	for _, column := range strings.Split(value, ",") {
+		column = strings.TrimSpace(column)
+
+		if !slices.Contains(statusNames, column) {
+			return fmt.Errorf("invalid status %q in %q, expected %s", column, value, strings.Join(statusNames, ", "))
+		}
+
+		if seen[column] {
+			return fmt.Errorf("status %q is listed twice in %q", column, value)
+		}
+
+		seen[column] = true
+	}
+
+	return nil
+}
+
+func wipLimits(value string) error {
+	for _, item := range splitList(value) {
+		column, limit, found := strings.Cut(item, "=")
+		column = strings.TrimSpace(column)
+
+		if !found || !slices.Contains(statusNames, column) {
+			return fmt.Errorf("invalid limit %q, expected <status>=<number> with a status of %s", item, strings.Join(statusNames, ", "))
+		}
+
+		if n, err := strconv.Atoi(strings.TrimSpace(limit)); err != nil || n < 0 {
+			return fmt.Errorf("invalid limit %q, expected a non-negative number of tasks", item)
+		}
+	}
+
+	return nil
+}
+
+func urlList(value string) error {
+	for _, item := range splitList(value) {
+		parsed, err := url.Parse(item)
+
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid URL %q, expected an http or https URL", item)
+		}
+	}
+
+	return nil
+}
+
+func eventList(value string) error {
+	for _, event := range splitList(value) {
+		if !slices.Contains(eventNames, event) {
+			return fmt.Errorf("invalid event %q, expected %s", event, strings.Join(eventNames, ", "))
+		}
+	}
+
+	return nil
+}
+
+// splitList returns the trimmed, non-empty items of a comma separated value.
+func splitList(value string) []string {
+	var items []string
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
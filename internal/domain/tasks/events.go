@@ -0,0 +1,32 @@
+package tasks
+
+import "time"
+
+type EventType string
+
+const (
+	TaskCreated   EventType = "task.created"
+	TaskUpdated   EventType = "task.updated"
+	StatusChanged EventType = "task.status_changed"
+	TaskDeleted   EventType = "task.deleted"
+	TaskArchived  EventType = "task.archived"
+)
+
+// Event describes a change to a task.
+type Event struct {
+	Type EventType
+	// Task is the task after the change, or the removed task for TaskDeleted and TaskArchived.
+	Task Task
+	// PreviousStatus is only meaningful for StatusChanged.
+	PreviousStatus Status
+	OccurredAt     time.Time
+}
+
+// EventPublisher receives the events emitted by the task operations.
+type EventPublisher interface {
+	// Before is called with the pending change right before it is saved, an error aborts the change.
+	Before(event Event) error
+	// Publish is called once the change is saved. It must not fail the operation that already succeeded,
+	// so it reports no error.
+	Publish(event Event)
+}
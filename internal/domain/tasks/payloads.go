@@ -0,0 +1,71 @@
+package tasks
+
+import "time"
+
+// TaskPayload is the JSON form of a task shown outside the program: by the HTTP API, to webhooks and
+// to hook scripts. Unlike Task its field names and values are a documented contract.
+type TaskPayload struct {
+	Id          int        `json:"id"`
+	Uuid        string     `json:"uuid"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	Priority    string     `json:"priority"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	DueAt       *time.Time `json:"dueAt,omitempty"`
+	RemindAt    *time.Time `json:"remindAt,omitempty"`
+	CreatedBy   string     `json:"createdBy,omitempty"`
+	Assignee    string     `json:"assignee,omitempty"`
+}
+
+// EventPayload is the JSON form of an event sent to webhooks and hook scripts.
+type EventPayload struct {
+	Event      string      `json:"event"`
+	OccurredAt time.Time   `json:"occurredAt"`
+	Task       TaskPayload `json:"task"`
+	// PreviousStatus is only set for StatusChanged.
+	PreviousStatus string `json:"previousStatus,omitempty"`
+}
+
+func NewTaskPayload(task Task) TaskPayload {
+	payload := TaskPayload{
+		Id:          task.Id,
+		Uuid:        task.Uuid,
+		Description: task.Description,
+		Status:      task.CurrentStatus.String(),
+		Priority:    task.Priority.String(),
+		CreatedAt:   task.CreatedAt,
+		UpdatedAt:   task.UpdatedAt,
+		CreatedBy:   task.CreatedBy,
+		Assignee:    task.Assignee,
+	}
+
+	if !task.CompletedAt.IsZero() {
+		payload.CompletedAt = &task.CompletedAt
+	}
+
+	if !task.DueAt.IsZero() {
+		payload.DueAt = &task.DueAt
+	}
+
+	if !task.RemindAt.IsZero() {
+		payload.RemindAt = &task.RemindAt
+	}
+
+	return payload
+}
+
+func NewEventPayload(event Event) EventPayload {
+	payload := EventPayload{
+		Event:      string(event.Type),
+		OccurredAt: event.OccurredAt,
+		Task:       NewTaskPayload(event.Task),
+	}
+
+	if event.Type == StatusChanged {
+		payload.PreviousStatus = event.PreviousStatus.String()
+	}
+
+	return payload
+}
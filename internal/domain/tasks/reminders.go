@@ -0,0 +1,14 @@
+package tasks
+
+import "time"
+
+// Notifier delivers the reminder of a task to the user.
+type Notifier interface {
+	Notify(task Task) error
+}
+
+// ReminderLog remembers which reminders already fired, as the RemindAt of the fired reminder by task UUID.
+type ReminderLog interface {
+	Load() (map[string]time.Time, error)
+	Save(fired map[string]time.Time) error
+}
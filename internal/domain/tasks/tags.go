@@ -0,0 +1,79 @@
+package tasks
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	TagPrefix     = "#"
+	ProjectPrefix = "+"
+)
+
+// Tags returns the #tags written in the task description, lower-cased and without the prefix.
+func (t Task) Tags() []string {
+	return descriptionMarkers(t.Description, TagPrefix)
+}
+
+// Projects returns the +projects written in the task description (todo.txt style),
+// lower-cased and without the prefix.
+func (t Task) Projects() []string {
+	return descriptionMarkers(t.Description, ProjectPrefix)
+}
+
+// HasTag reports whether the task carries the tag, given with or without its prefix.
+func (t Task) HasTag(tag string) bool {
+	return containsMarker(t.Tags(), strings.TrimPrefix(tag, TagPrefix))
+}
+
+// HasProject reports whether the task belongs to the project, given with or without its prefix.
+func (t Task) HasProject(project string) bool {
+	return containsMarker(t.Projects(), strings.TrimPrefix(project, ProjectPrefix))
+}
+
+// DescriptionWithoutTags returns the task description with its #tags removed.
+func (t Task) DescriptionWithoutTags() string {
+	var words []string
+
+	for _, word := range strings.Fields(t.Description) {
+		if !isMarker(word, TagPrefix) {
+			words = append(words, word)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// descriptionMarkers collects words starting with prefix followed by a letter, so "#42" or "+1" are not markers.
+func descriptionMarkers(description, prefix string) []string {
+	var markers []string
+
+	for _, word := range strings.Fields(description) {
+		if isMarker(word, prefix) {
+			markers = append(markers, strings.ToLower(markerName(word, prefix)))
+		}
+	}
+
+	return markers
+}
+
+func isMarker(word, prefix string) bool {
+	name := markerName(word, prefix)
+	return strings.HasPrefix(word, prefix) && name != "" && unicode.IsLetter([]rune(name)[0])
+}
+
+func markerName(word, prefix string) string {
+	return strings.TrimRight(strings.TrimPrefix(word, prefix), ".,;:!?)")
+}
+
+func containsMarker(markers []string, name string) bool {
+	name = strings.ToLower(name)
+
+	for _, marker := range markers {
+		if marker == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -10,6 +10,15 @@ const (
 	Done
 )
 
+type Priority int
+
+const (
+	NoPriority Priority = iota
+	LowPriority
+	MediumPriority
+	HighPriority
+)
+
 const (
 	TodoStr       = "todo"
 	InProgressStr = "in-progress"
@@ -17,17 +26,71 @@ const (
 	UnknownStr    = "unknown"
 )
 
+const (
+	NoPriorityStr     = "none"
+	LowPriorityStr    = "low"
+	MediumPriorityStr = "medium"
+	HighPriorityStr   = "high"
+)
+
 type Task struct {
 	Id            int
+	Uuid          string
 	Description   string
 	CurrentStatus Status
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	// CompletedAt is the moment the task was last marked as done, zero while it is not done.
+	CompletedAt time.Time `json:",omitzero"`
+	Priority    Priority  `json:",omitempty"`
+	DueAt       time.Time `json:",omitzero"`
+	// RemindAt is when the user wants to be reminded of the task, zero without a reminder.
+	RemindAt time.Time `json:",omitzero"`
+	// CreatedBy is the user who added the task.
+	CreatedBy string `json:",omitempty"`
+	// Assignee is the user responsible for the task, empty while nobody is.
+	Assignee string `json:",omitempty"`
+	// TimeEntries are the periods spent working on the task, e.g. completed focus sessions.
+	TimeEntries []TimeEntry `json:",omitempty"`
+	// Comments is the discussion of the task, oldest first.
+	Comments []Comment `json:",omitempty"`
+}
+
+// TimeEntry is a period of work on a task.
+type TimeEntry struct {
+	StartedAt time.Time
+	EndedAt   time.Time
+}
+
+// Comment is a remark on a task. Only its author may edit or delete it.
+type Comment struct {
+	// Id numbers the comments of a task, starting at 1.
+	Id        int
+	Author    string
+	Body      string
+	CreatedAt time.Time
+	// UpdatedAt is the moment of the last edit, zero while the comment is unchanged.
+	UpdatedAt time.Time `json:",omitzero"`
+}
+
+// Duration returns how long the work lasted.
+func (e TimeEntry) Duration() time.Duration {
+	return e.EndedAt.Sub(e.StartedAt)
 }
 
 type TaskStorage interface {
 	Save(tasks []Task) error
 	Load() ([]Task, error)
+	// NextId returns the ID of the next new task. IDs are never handed out twice, even after the task that
+	// owned them has been deleted. An ID is only taken by saving a task with it, so NextId returns the same ID
+	// until then and a batch of new tasks numbers on from it.
+	NextId() (int, error)
+}
+
+// TaskArchive keeps tasks that were moved out of the active task list.
+type TaskArchive interface {
+	Save(tasks []Task) error
+	Load() ([]Task, error)
 }
 
 func (s Status) String() string {
@@ -42,3 +105,18 @@ func (s Status) String() string {
 		return UnknownStr
 	}
 }
+
+func (p Priority) String() string {
+	switch p {
+	case NoPriority:
+		return NoPriorityStr
+	case LowPriority:
+		return LowPriorityStr
+	case MediumPriority:
+		return MediumPriorityStr
+	case HighPriority:
+		return HighPriorityStr
+	default:
+		return UnknownStr
+	}
+}
@@ -0,0 +1,26 @@
+package tasks
+
+// Template is a named list of tasks that are created together, e.g. a release checklist.
+type Template struct {
+	Name  string
+	Tasks []TemplateTask
+}
+
+// TemplateTask describes one task of a template. Description and Tags may contain {{name}} placeholders
+// that are replaced by the variables given when the template is applied.
+type TemplateTask struct {
+	Description string
+	Tags        []string `json:",omitempty"`
+	Priority    Priority `json:",omitempty"`
+	// Due is the due date relative to the moment the template is applied, e.g. "3d", empty without one.
+	Due string `json:",omitempty"`
+}
+
+// TemplateStore keeps the task templates.
+type TemplateStore interface {
+	Save(template Template) error
+	Load(name string) (Template, error)
+	// List returns the names of all templates in alphabetical order.
+	List() ([]string, error)
+	Delete(name string) error
+}
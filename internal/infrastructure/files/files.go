@@ -2,9 +2,12 @@ package files
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 const (
@@ -13,8 +16,16 @@ const (
 	appName         = "TaskTracker-CLI"
 )
 
+// saveDir replaces the default save directory when set.
+var saveDir string
+
+// SetSaveDir makes dir the directory for all save files. An empty dir restores the default.
+func SetSaveDir(dir string) {
+	saveDir = dir
+}
+
 func SaveToFile[T ~[]E, E any](data T) error {
-	saveFile, err := openOrCreateSaveFile()
+	saveFile, err := createFile(saveFileName)
 
 	if err != nil {
 		return err
@@ -23,8 +34,9 @@ func SaveToFile[T ~[]E, E any](data T) error {
 	return saveToFile(saveFile, data)
 }
 
+// GetFromFile reads the task file, a missing file holds no tasks.
 func GetFromFile[T ~[]E, E any]() (T, error) {
-	saveFile, err := openOrCreateSaveFile()
+	saveFile, err := openFile(saveFileName)
 
 	if err != nil {
 		return nil, err
@@ -33,7 +45,89 @@ func GetFromFile[T ~[]E, E any]() (T, error) {
 	return getFromFile[T](saveFile)
 }
 
-func saveToFile[T ~[]E, E any](file io.WriteCloser, data T) error {
+// SaveToNamedFile stores data as JSON in the file with the given name inside the save directory.
+func SaveToNamedFile[T any](fileName string, data T) error {
+	file, err := createFile(fileName)
+
+	if err != nil {
+		return err
+	}
+
+	return saveToFile(file, data)
+}
+
+// GetFromNamedFile reads JSON data from the file with the given name inside the save directory.
+// A missing or empty file results in the zero value of T.
+func GetFromNamedFile[T any](fileName string) (T, error) {
+	file, err := openFile(fileName)
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return getObjectFromFile[T](file)
+}
+
+// AppendToNamedFile appends data to the file with the given name inside the save directory.
+func AppendToNamedFile(fileName string, data []byte) error {
+	if err := ensureSaveDirExists(); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(getFilePath(fileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	_, err = file.Write(data)
+	return err
+}
+
+// NamedFileExists reports whether the file with the given name exists inside the save directory.
+func NamedFileExists(fileName string) (bool, error) {
+	_, err := os.Stat(getFilePath(fileName))
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// ListNamedFiles returns the names of the files in the directory dirName inside the save directory.
+// A missing directory holds no files.
+func ListNamedFiles(dirName string) ([]string, error) {
+	entries, err := os.ReadDir(getFilePath(dirName))
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
+
+// RemoveNamedFile deletes the file with the given name inside the save directory.
+func RemoveNamedFile(fileName string) error {
+	return os.Remove(getFilePath(fileName))
+}
+
+func saveToFile[T any](file io.WriteCloser, data T) error {
 	defer file.Close()
 
 	encoder := json.NewEncoder(file)
@@ -42,6 +136,16 @@ func saveToFile[T ~[]E, E any](file io.WriteCloser, data T) error {
 }
 
 func getFromFile[T ~[]E, E any](file io.ReadCloser) (T, error) {
+	data, err := getObjectFromFile[T](file)
+
+	if err == nil && data == nil {
+		data = make(T, 0)
+	}
+
+	return data, err
+}
+
+func getObjectFromFile[T any](file io.ReadCloser) (T, error) {
 	defer file.Close()
 
 	var data T
@@ -50,10 +154,6 @@ func getFromFile[T ~[]E, E any](file io.ReadCloser) (T, error) {
 
 	if err == io.EOF {
 		err = nil
-
-		if data == nil {
-			data = make(T, 0)
-		}
 	}
 
 	return data, err
@@ -69,12 +169,14 @@ func ensureSaveDirExists() error {
 	return err
 }
 
-func openOrCreateSaveFile() (*os.File, error) {
-	if err := ensureSaveDirExists(); err != nil {
-		return nil, err
-	}
+// openFile opens the file for reading. A missing file reads as empty and is not created, reading never
+// changes the save directory.
+func openFile(fileName string) (io.ReadCloser, error) {
+	f, err := os.Open(getFilePath(fileName))
 
-	f, err := os.OpenFile(getSavePath(), os.O_CREATE|os.O_RDWR, 0644)
+	if errors.Is(err, fs.ErrNotExist) {
+		return io.NopCloser(strings.NewReader("")), nil
+	}
 
 	if err != nil {
 		return nil, err
@@ -83,17 +185,39 @@ func openOrCreateSaveFile() (*os.File, error) {
 	return f, nil
 }
 
-func getSavePath() string {
-	path, err := os.UserConfigDir()
-
-	if err != nil {
-		path = defaultSavePath
+// createFile truncates or creates the file, file names may include a directory inside the save directory.
+func createFile(fileName string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(getFilePath(fileName)), 0755); err != nil {
+		return nil, err
 	}
 
-	return filepath.Join(path, appName, saveFileName)
+	return os.OpenFile(getFilePath(fileName), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+}
+
+// SaveDir returns the directory that holds the save files.
+func SaveDir() string {
+	return getSaveDir()
+}
+
+// SaveFilePath returns the path of the task file.
+func SaveFilePath() string {
+	return getFilePath(saveFileName)
+}
+
+// FilePath returns the path of the file with the given name inside the save directory.
+func FilePath(fileName string) string {
+	return getFilePath(fileName)
+}
+
+func getFilePath(fileName string) string {
+	return filepath.Join(getSaveDir(), fileName)
 }
 
 func getSaveDir() string {
+	if saveDir != "" {
+		return saveDir
+	}
+
 	path, err := os.UserConfigDir()
 
 	if err != nil {
@@ -2,12 +2,14 @@
 package files
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	mocks2 "github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/files/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"io"
+	"path/filepath"
 	"testing"
 )
 
@@ -120,11 +122,8 @@ func TestGetFromFile(t *testing.T) {
 				correctJson = append(correctJson, '\n')
 
 				result := mocks2.NewMockReadCloser(ctrl)
-				firstCall := result.EXPECT().Read(gomock.Any()).DoAndReturn(
-					func(p []byte) (n int, err error) {
-						copy(p, correctJson)
-						return len(correctJson), nil
-					}).Times(1)
+				reader := bytes.NewReader(correctJson)
+				firstCall := result.EXPECT().Read(gomock.Any()).DoAndReturn(reader.Read).MinTimes(1)
 				result.EXPECT().Close().Times(1).After(firstCall)
 
 				return result
@@ -182,3 +181,19 @@ func TestGetFromFile(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFromMissingFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tasks")
+	SetSaveDir(dir)
+	t.Cleanup(func() { SetSaveDir("") })
+
+	tasks, err := GetFromFile[[]mocks2.TaskMock]()
+	assert.NoError(t, err)
+	assert.Empty(t, tasks)
+
+	archived, err := GetFromNamedFile[[]mocks2.TaskMock]("archive.json")
+	assert.NoError(t, err)
+	assert.Nil(t, archived)
+
+	assert.NoDirExists(t, dir, "reading does not create the save directory or its files")
+}
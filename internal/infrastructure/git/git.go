@@ -0,0 +1,143 @@
+// Package git runs git commands inside a working directory.
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	fallbackUserName  = "task-cli"
+	fallbackUserEmail = "task-cli@localhost"
+)
+
+// Repository is a git working tree.
+type Repository struct {
+	dir string
+}
+
+func Open(dir string) *Repository {
+	return &Repository{dir: dir}
+}
+
+func (r *Repository) Dir() string {
+	return r.dir
+}
+
+// IsRepository reports whether the directory is the root of a git working tree.
+func (r *Repository) IsRepository() bool {
+	_, err := os.Stat(filepath.Join(r.dir, ".git"))
+	return err == nil
+}
+
+// Init creates a new repository whose first branch is named branch.
+func (r *Repository) Init(branch string) error {
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return err
+	}
+
+	if _, err := r.Run("init", "--quiet"); err != nil {
+		return err
+	}
+
+	_, err := r.Run("symbolic-ref", "HEAD", "refs/heads/"+branch)
+	return err
+}
+
+// Run executes git with args and returns its trimmed standard output.
+// A failing command returns an error carrying git's standard error.
+func (r *Repository) Run(args ...string) (string, error) {
+	return r.run(nil, args...)
+}
+
+func (r *Repository) run(env []string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	command := exec.Command("git", args...)
+	command.Dir = r.dir
+	command.Env = append(os.Environ(), env...)
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+
+	if err := command.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = strings.TrimSpace(stdout.String())
+		}
+		if message == "" {
+			message = err.Error()
+		}
+
+		return "", fmt.Errorf("git %s: %s", args[0], message)
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// Succeeds runs git with args and reports whether it exited successfully.
+func (r *Repository) Succeeds(args ...string) bool {
+	_, err := r.Run(args...)
+	return err == nil
+}
+
+// Show returns the content of path at the given revision, or nil when the path does not exist there.
+func (r *Repository) Show(revision, path string) ([]byte, error) {
+	if !r.Succeeds("cat-file", "-e", revision+":"+path) {
+		return nil, nil
+	}
+
+	content, err := r.Run("show", revision+":"+path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(content), nil
+}
+
+// CommitAll stages every change and commits it. It reports false when there was nothing to commit.
+func (r *Repository) CommitAll(message string) (bool, error) {
+	if _, err := r.Run("add", "--all"); err != nil {
+		return false, err
+	}
+
+	if r.Succeeds("diff", "--cached", "--quiet") && r.Succeeds("rev-parse", "--verify", "--quiet", "HEAD") {
+		return false, nil
+	}
+
+	return true, r.Commit("--message", message)
+}
+
+// Commit runs git commit with args.
+func (r *Repository) Commit(args ...string) error {
+	_, err := r.run(r.identity(), append([]string{"commit", "--quiet", "--allow-empty"}, args...)...)
+	return err
+}
+
+// Merge runs git merge with args.
+func (r *Repository) Merge(args ...string) error {
+	_, err := r.run(r.identity(), append([]string{"merge", "--quiet"}, args...)...)
+	return err
+}
+
+// identity returns a placeholder author and committer when the user has not configured one,
+// commits made by task-cli should not fail for that.
+func (r *Repository) identity() []string {
+	if r.Succeeds("config", "--get", "user.email") || os.Getenv("GIT_AUTHOR_EMAIL") != "" {
+		return nil
+	}
+
+	return []string{
+		"GIT_AUTHOR_NAME=" + fallbackUserName, "GIT_AUTHOR_EMAIL=" + fallbackUserEmail,
+		"GIT_COMMITTER_NAME=" + fallbackUserName, "GIT_COMMITTER_EMAIL=" + fallbackUserEmail,
+	}
+}
+
+// WriteFile writes data to a file in the working tree.
+func (r *Repository) WriteFile(path string, data []byte) error {
+	return os.WriteFile(filepath.Join(r.dir, path), data, 0644)
+}
@@ -0,0 +1,141 @@
+// Package hooks runs user scripts from a hooks directory when tasks change, similar to git hooks.
+//
+// Every change has a pre-<name> hook that runs before the change is saved and can abort it by
+// exiting with a non-zero status, and a post-<name> hook that runs after the change is saved.
+// The names are add, change, complete and delete.
+package hooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+)
+
+// Error reports a pre-* hook that rejected a change.
+type Error struct {
+	Hook     string
+	ExitCode int
+	Stderr   string
+}
+
+func (e *Error) Error() string {
+	message := fmt.Sprintf("%s hook failed with exit status %d", e.Hook, e.ExitCode)
+
+	if e.Stderr != "" {
+		message += ": " + e.Stderr
+	}
+
+	return message
+}
+
+// Runner runs the hooks found in a directory.
+type Runner struct {
+	dir    string
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// NewRunner creates a Runner for the hooks in dir. Output of the hooks goes to stdout and stderr,
+// except the stderr of a failing pre-* hook which is returned in its Error.
+func NewRunner(dir string, stdout, stderr io.Writer) *Runner {
+	return &Runner{dir: dir, stdout: stdout, stderr: stderr}
+}
+
+// HookName returns the name of the change an event stands for: add, change, complete, delete or archive.
+func HookName(event domain.Event) string {
+	switch event.Type {
+	case domain.TaskCreated:
+		return "add"
+	case domain.StatusChanged:
+		if event.Task.CurrentStatus == domain.Done {
+			return "complete"
+		}
+
+		return "change"
+	case domain.TaskDeleted:
+		return "delete"
+	case domain.TaskArchived:
+		return "archive"
+	default:
+		return "change"
+	}
+}
+
+// Before runs the pre-* hook of the event and returns an *Error when it exits with a non-zero status.
+func (r *Runner) Before(event domain.Event) error {
+	hook := "pre-" + HookName(event)
+	var stderr bytes.Buffer
+
+	err := r.run(hook, event, &stderr)
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return &Error{Hook: hook, ExitCode: exitErr.ExitCode(), Stderr: strings.TrimSpace(stderr.String())}
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s hook: %w", hook, err)
+	}
+
+	_, _ = r.stderr.Write(stderr.Bytes())
+	return nil
+}
+
+// Publish runs the post-* hook of the event. The change is already saved, so a failure is only reported.
+func (r *Runner) Publish(event domain.Event) {
+	hook := "post-" + HookName(event)
+
+	if err := r.run(hook, event, r.stderr); err != nil {
+		_, _ = fmt.Fprintf(r.stderr, "Warning: %s hook failed: %s\n", hook, err.Error())
+	}
+}
+
+// run executes the hook with the event as JSON on stdin, the payload webhooks receive. A missing hook is not an error.
+func (r *Runner) run(hook string, event domain.Event, stderr io.Writer) error {
+	path := filepath.Join(r.dir, hook)
+
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	input, err := json.Marshal(domain.NewEventPayload(event))
+
+	if err != nil {
+		return err
+	}
+
+	command := exec.Command(path)
+	command.Dir = r.dir
+	command.Stdin = bytes.NewReader(input)
+	command.Stdout = r.stdout
+	command.Stderr = stderr
+	command.Env = append(os.Environ(), environment(hook, event)...)
+
+	return command.Run()
+}
+
+func environment(hook string, event domain.Event) []string {
+	env := []string{
+		"TASK_HOOK=" + hook,
+		"TASK_EVENT=" + string(event.Type),
+		"TASK_ID=" + strconv.Itoa(event.Task.Id),
+		"TASK_UUID=" + event.Task.Uuid,
+		"TASK_STATUS=" + event.Task.CurrentStatus.String(),
+	}
+
+	if event.Type == domain.StatusChanged {
+		env = append(env, "TASK_PREVIOUS_STATUS="+event.PreviousStatus.String())
+	}
+
+	return env
+}
@@ -0,0 +1,168 @@
+package hooks
+
+import (
+	"bytes"
+	"encoding/json"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testTask = domain.Task{
+	Id:            4,
+	Uuid:          "3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f",
+	Description:   "Write report",
+	CurrentStatus: domain.Done,
+	CreatedAt:     time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC),
+	UpdatedAt:     time.Date(2025, 9, 2, 9, 0, 0, 0, time.UTC),
+}
+
+func writeHook(t *testing.T, dir, name, script string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script), 0755))
+}
+
+func skipOnWindows(t *testing.T) {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("hook scripts in tests are shell scripts")
+	}
+}
+
+func TestHookName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		event    domain.Event
+		expected string
+	}{
+		{name: "Created", event: domain.Event{Type: domain.TaskCreated}, expected: "add"},
+		{name: "Updated", event: domain.Event{Type: domain.TaskUpdated}, expected: "change"},
+		{name: "Marked done", event: domain.Event{Type: domain.StatusChanged, Task: domain.Task{CurrentStatus: domain.Done}}, expected: "complete"},
+		{name: "Marked in progress", event: domain.Event{Type: domain.StatusChanged, Task: domain.Task{CurrentStatus: domain.InProgress}}, expected: "change"},
+		{name: "Deleted", event: domain.Event{Type: domain.TaskDeleted}, expected: "delete"},
+		{name: "Archived", event: domain.Event{Type: domain.TaskArchived}, expected: "archive"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, HookName(tt.event))
+		})
+	}
+}
+
+func TestBefore(t *testing.T) {
+	t.Parallel()
+	skipOnWindows(t)
+
+	tests := []struct {
+		name          string
+		script        string
+		expectedErr   string
+		expectedLog   string
+		expectedInput bool
+	}{
+		{
+			name:          "Accepting hook",
+			script:        "cat > input.json\necho \"$TASK_HOOK $TASK_EVENT $TASK_ID $TASK_UUID $TASK_STATUS $TASK_PREVIOUS_STATUS\" >&2\n",
+			expectedLog:   "pre-complete task.status_changed 4 3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f done in-progress\n",
+			expectedInput: true,
+		},
+		{
+			name:        "Rejecting hook",
+			script:      "echo 'reports must be reviewed first' >&2\nexit 3\n",
+			expectedErr: "pre-complete hook failed with exit status 3: reports must be reviewed first",
+		},
+		{
+			name:        "Rejecting hook without output",
+			script:      "exit 1\n",
+			expectedErr: "pre-complete hook failed with exit status 1",
+		},
+		{
+			name: "Missing hook",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			if tt.script != "" {
+				writeHook(t, dir, "pre-complete", tt.script)
+			}
+
+			var stdout, stderr bytes.Buffer
+			runner := NewRunner(dir, &stdout, &stderr)
+
+			err := runner.Before(domain.Event{Type: domain.StatusChanged, Task: testTask, PreviousStatus: domain.InProgress})
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				assert.Empty(t, stderr.String())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedLog, stderr.String())
+
+			if tt.expectedInput {
+				input, err := os.ReadFile(filepath.Join(dir, "input.json"))
+				require.NoError(t, err)
+
+				var payload domain.EventPayload
+				require.NoError(t, json.Unmarshal(input, &payload))
+				assert.Equal(t, "task.status_changed", payload.Event)
+				assert.Equal(t, "in-progress", payload.PreviousStatus)
+				assert.Equal(t, testTask.Id, payload.Task.Id)
+				assert.Equal(t, "done", payload.Task.Status)
+				assert.Contains(t, string(input), `"description":"`+testTask.Description+`"`)
+			}
+		})
+	}
+}
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+	skipOnWindows(t)
+
+	dir := t.TempDir()
+	writeHook(t, dir, "post-add", "echo \"added $TASK_ID\"\n")
+	writeHook(t, dir, "post-delete", "echo 'cleanup failed' >&2\nexit 2\n")
+
+	var stdout, stderr bytes.Buffer
+	runner := NewRunner(dir, &stdout, &stderr)
+
+	runner.Publish(domain.Event{Type: domain.TaskCreated, Task: testTask})
+	runner.Publish(domain.Event{Type: domain.TaskUpdated, Task: testTask})
+	runner.Publish(domain.Event{Type: domain.TaskDeleted, Task: testTask})
+
+	assert.Equal(t, "added 4\n", stdout.String())
+	assert.Equal(t, "cleanup failed\nWarning: post-delete hook failed: exit status 2\n", stderr.String())
+}
+
+func TestBeforeNotExecutable(t *testing.T) {
+	t.Parallel()
+	skipOnWindows(t)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pre-add"), []byte("#!/bin/sh\n"), 0644))
+
+	err := NewRunner(dir, &bytes.Buffer{}, &bytes.Buffer{}).Before(domain.Event{Type: domain.TaskCreated})
+
+	require.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "pre-add hook: "), err.Error())
+}
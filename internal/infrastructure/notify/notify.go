@@ -0,0 +1,145 @@
+// Package notify shows task reminders to the user: as a desktop notification through notify-send,
+// through a user supplied shell command, or as a line of text when neither is available.
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+)
+
+// Title is the headline of every reminder.
+const Title = "Task reminder"
+
+const desktopCommand = "notify-send"
+
+// DateFormatter renders the dates of a reminder message, so that they read like everywhere else.
+type DateFormatter func(t time.Time) string
+
+// New returns the notifier to use: the shell command when one is configured, a desktop notification
+// when notify-send is installed, and a line written to out otherwise.
+func New(command string, out io.Writer, formatDate DateFormatter) domain.Notifier {
+	if command != "" {
+		return NewCommand(command, out, formatDate)
+	}
+
+	if path, err := exec.LookPath(desktopCommand); err == nil {
+		return &Desktop{path: path, formatDate: formatDate}
+	}
+
+	return NewConsole(out, formatDate)
+}
+
+// Message is the text of the reminder of task, its due date rendered with formatDate.
+func Message(task domain.Task, formatDate DateFormatter) string {
+	message := fmt.Sprintf("#%d %s", task.Id, task.Description)
+
+	if !task.DueAt.IsZero() {
+		message += fmt.Sprintf(" (due %s)", formatDate(task.DueAt))
+	}
+
+	return message
+}
+
+// Desktop shows reminders as desktop notifications with notify-send.
+type Desktop struct {
+	path       string
+	formatDate DateFormatter
+}
+
+func (d *Desktop) Notify(task domain.Task) error {
+	var stderr bytes.Buffer
+
+	command := exec.Command(d.path, "--app-name=task-cli", Title, Message(task, d.formatDate))
+	command.Stderr = &stderr
+
+	if err := command.Run(); err != nil {
+		return commandError(desktopCommand, err, stderr.String())
+	}
+
+	return nil
+}
+
+// Command runs a shell command for every reminder. The title and the message are passed as $1 and $2,
+// the task as TASK_ID, TASK_UUID, TASK_DESCRIPTION, TASK_STATUS, TASK_REMIND_AT and TASK_DUE_AT.
+type Command struct {
+	command    string
+	out        io.Writer
+	formatDate DateFormatter
+}
+
+// NewCommand creates a notifier running command, its output goes to out.
+func NewCommand(command string, out io.Writer, formatDate DateFormatter) *Command {
+	return &Command{command: command, out: out, formatDate: formatDate}
+}
+
+func (c *Command) Notify(task domain.Task) error {
+	var stderr bytes.Buffer
+
+	command := shellCommand(c.command, Title, Message(task, c.formatDate))
+	command.Stdout = c.out
+	command.Stderr = &stderr
+	command.Env = append(os.Environ(), environment(task)...)
+
+	if err := command.Run(); err != nil {
+		return commandError("reminder command", err, stderr.String())
+	}
+
+	return nil
+}
+
+// Console writes reminders as lines of text.
+type Console struct {
+	out        io.Writer
+	formatDate DateFormatter
+}
+
+func NewConsole(out io.Writer, formatDate DateFormatter) *Console {
+	return &Console{out: out, formatDate: formatDate}
+}
+
+func (c *Console) Notify(task domain.Task) error {
+	_, err := fmt.Fprintf(c.out, "%s: %s\n", Title, Message(task, c.formatDate))
+	return err
+}
+
+func shellCommand(command string, args ...string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.Command("cmd", append([]string{"/C", command}, args...)...)
+	}
+
+	// The first argument after the script becomes $0.
+	return exec.Command("sh", append([]string{"-c", command, "task-cli"}, args...)...)
+}
+
+func environment(task domain.Task) []string {
+	env := []string{
+		"TASK_ID=" + strconv.Itoa(task.Id),
+		"TASK_UUID=" + task.Uuid,
+		"TASK_DESCRIPTION=" + task.Description,
+		"TASK_STATUS=" + task.CurrentStatus.String(),
+		"TASK_REMIND_AT=" + task.RemindAt.Format(time.RFC3339),
+	}
+
+	if !task.DueAt.IsZero() {
+		env = append(env, "TASK_DUE_AT="+task.DueAt.Format(time.RFC3339))
+	}
+
+	return env
+}
+
+func commandError(name string, err error, stderr string) error {
+	if stderr = strings.TrimSpace(stderr); stderr != "" {
+		return fmt.Errorf("%s failed: %w: %s", name, err, stderr)
+	}
+
+	return fmt.Errorf("%s failed: %w", name, err)
+}
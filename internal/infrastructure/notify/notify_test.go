@@ -0,0 +1,79 @@
+package notify
+
+import (
+	"bytes"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/stretchr/testify/assert"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+)
+
+var reminderTask = domain.Task{
+	Id:          7,
+	Uuid:        "3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f",
+	Description: "Call mom",
+	RemindAt:    time.Date(2025, 9, 10, 9, 0, 0, 0, time.UTC),
+}
+
+func formatDate(t time.Time) string {
+	return t.UTC().Format("02 Jan 15:04")
+}
+
+func skipWithoutShell(t *testing.T) {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("the command notifier tests use sh")
+	}
+
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not installed")
+	}
+}
+
+func TestMessage(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "#7 Call mom", Message(reminderTask, formatDate))
+
+	task := reminderTask
+	task.DueAt = time.Date(2025, 9, 12, 18, 30, 0, 0, time.UTC)
+	assert.Equal(t, "#7 Call mom (due 12 Sep 18:30)", Message(task, formatDate))
+}
+
+func TestCommand(t *testing.T) {
+	t.Parallel()
+	skipWithoutShell(t)
+
+	var out bytes.Buffer
+	notifier := NewCommand(`echo "$1|$2|$TASK_ID|$TASK_UUID|$TASK_REMIND_AT"`, &out, formatDate)
+
+	assert.NoError(t, notifier.Notify(reminderTask))
+	assert.Equal(t, "Task reminder|#7 Call mom|7|3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f|2025-09-10T09:00:00Z\n", out.String())
+}
+
+func TestCommandFailure(t *testing.T) {
+	t.Parallel()
+	skipWithoutShell(t)
+
+	notifier := NewCommand("echo no display >&2; exit 3", &bytes.Buffer{}, formatDate)
+
+	assert.EqualError(t, notifier.Notify(reminderTask), "reminder command failed: exit status 3: no display")
+}
+
+func TestConsole(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	assert.NoError(t, NewConsole(&out, formatDate).Notify(reminderTask))
+	assert.Equal(t, "Task reminder: #7 Call mom\n", out.String())
+}
+
+func TestNewPrefersCommand(t *testing.T) {
+	t.Parallel()
+
+	assert.IsType(t, &Command{}, New("notify-me", &bytes.Buffer{}, formatDate))
+}
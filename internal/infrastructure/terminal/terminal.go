@@ -0,0 +1,12 @@
+// Package terminal provides the small amount of terminal control the interactive views need:
+// switching a terminal into raw mode and querying its size.
+package terminal
+
+import "errors"
+
+var ErrUnsupported = errors.New("terminal control is not supported on this platform")
+
+// State is the terminal state saved by MakeRaw and needed to restore it.
+type State struct {
+	state termState
+}
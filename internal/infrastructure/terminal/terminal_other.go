@@ -0,0 +1,38 @@
+//go:build !linux && !darwin && !freebsd
+
+package terminal
+
+import "os"
+
+// Supported reports whether raw mode and the terminal size are available on this platform.
+const Supported = false
+
+type termState struct{}
+
+// IsTerminal reports whether fd refers to a terminal. Terminals are never detected on this platform.
+func IsTerminal(fd uintptr) bool {
+	return false
+}
+
+// MakeRaw is not supported on this platform.
+func MakeRaw(fd uintptr) (*State, error) {
+	return nil, ErrUnsupported
+}
+
+// Restore is not supported on this platform.
+func Restore(fd uintptr, state *State) error {
+	return ErrUnsupported
+}
+
+// Size is not supported on this platform.
+func Size(fd uintptr) (int, int, error) {
+	return 0, 0, ErrUnsupported
+}
+
+// NotifyResize does nothing, terminal size changes are not reported on this platform.
+func NotifyResize(c chan<- os.Signal) {
+}
+
+// StopResize does nothing on this platform.
+func StopResize(c chan<- os.Signal) {
+}
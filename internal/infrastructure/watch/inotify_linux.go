@@ -0,0 +1,80 @@
+package watch
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+const directoryEvents = syscall.IN_MODIFY | syscall.IN_CREATE | syscall.IN_DELETE |
+	syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO | syscall.IN_DELETE_SELF | syscall.IN_MOVE_SELF
+
+var errDirectoryGone = errors.New("the watched directory was removed")
+
+// newEventWatcher watches the directory of path with inotify. The returned function reports the events
+// of the file until ctx is done, or returns an error when the events stop.
+func newEventWatcher(path string) (func(context.Context, func()) error, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+
+	if err != nil {
+		return nil, os.NewSyscallError("inotify_init1", err)
+	}
+
+	if _, err := syscall.InotifyAddWatch(fd, filepath.Dir(path), directoryEvents); err != nil {
+		syscall.Close(fd)
+		return nil, os.NewSyscallError("inotify_add_watch", err)
+	}
+
+	// A non-blocking descriptor is served by the runtime poller, so closing the file ends a pending read.
+	file := os.NewFile(uintptr(fd), "inotify")
+	name := filepath.Base(path)
+
+	return func(ctx context.Context, notify func()) error {
+		done := make(chan struct{})
+		defer close(done)
+
+		go func() {
+			select {
+			case <-ctx.Done():
+			case <-done:
+			}
+
+			file.Close()
+		}()
+
+		buffer := make([]byte, 64*(syscall.SizeofInotifyEvent+syscall.NAME_MAX+1))
+
+		for {
+			n, err := file.Read(buffer)
+
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				return err
+			}
+
+			for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+				mask := binary.NativeEndian.Uint32(buffer[offset+4:])
+				length := int(binary.NativeEndian.Uint32(buffer[offset+12:]))
+				start := offset + syscall.SizeofInotifyEvent
+				offset = start + length
+
+				switch {
+				case mask&syscall.IN_Q_OVERFLOW != 0:
+					// Events were dropped, one of them may have been about the file.
+					notify()
+				case mask&(syscall.IN_DELETE_SELF|syscall.IN_MOVE_SELF|syscall.IN_IGNORED) != 0:
+					return errDirectoryGone
+				case strings.TrimRight(string(buffer[start:offset]), "\x00") == name:
+					notify()
+				}
+			}
+		}
+	}, nil
+}
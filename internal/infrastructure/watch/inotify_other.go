@@ -0,0 +1,10 @@
+//go:build !linux
+
+package watch
+
+import "context"
+
+// newEventWatcher is not supported on this platform, files are polled instead.
+func newEventWatcher(path string) (func(context.Context, func()) error, error) {
+	return nil, errUnsupported
+}
@@ -0,0 +1,163 @@
+// Package watch reports changes of a file. It follows the directory of the file rather than the file itself,
+// so a file replaced by an atomic rename stays watched, and it polls the file where file system events
+// are not available.
+package watch
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+)
+
+const (
+	DefaultDebounce     = 150 * time.Millisecond
+	DefaultPollInterval = time.Second
+)
+
+var errUnsupported = errors.New("file system events are not supported on this platform")
+
+// Options tune a watch, zero values use the defaults.
+type Options struct {
+	// Debounce is how long the file has to stay quiet before a change is reported,
+	// so that a burst of writes results in a single change.
+	Debounce time.Duration
+	// PollInterval is how often the file is checked when it is polled.
+	PollInterval time.Duration
+	// Poll checks the file periodically even where file system events are available.
+	Poll bool
+}
+
+// File watches the file at path until ctx is done and sends on the returned channel after every change.
+// Changes that happen while the previous one is not received yet are merged into it.
+// The channel is closed when ctx is done.
+func File(ctx context.Context, path string, options Options) <-chan struct{} {
+	if options.Debounce <= 0 {
+		options.Debounce = DefaultDebounce
+	}
+
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultPollInterval
+	}
+
+	events := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case events <- struct{}{}:
+		default:
+		}
+	}
+
+	// Both sources are set up before File returns, so no change made after it is missed.
+	var watchEvents func(context.Context, func()) error
+	var err error = errUnsupported
+
+	if !options.Poll {
+		watchEvents, err = newEventWatcher(path)
+	}
+
+	var poller *poller
+	if err != nil {
+		poller = newPoller(path)
+	}
+
+	go func() {
+		if watchEvents != nil {
+			if err := watchEvents(ctx, notify); err == nil {
+				return
+			}
+
+			// The events stopped, e.g. because the directory was removed. The file most likely changed with it.
+			poller = newPoller(path)
+			notify()
+		}
+
+		poller.run(ctx, options.PollInterval, notify)
+	}()
+
+	changes := make(chan struct{}, 1)
+	go debounce(ctx, events, changes, options.Debounce)
+
+	return changes
+}
+
+// debounce sends on changes once no event arrived for the given delay.
+func debounce(ctx context.Context, events <-chan struct{}, changes chan<- struct{}, delay time.Duration) {
+	defer close(changes)
+
+	timer := time.NewTimer(delay)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-events:
+			timer.Reset(delay)
+		case <-timer.C:
+			select {
+			case changes <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// poller notices changes by comparing the state of the file between checks.
+type poller struct {
+	path string
+	last fileState
+}
+
+// fileState is what a poller remembers of a file, info is nil while the file does not exist.
+type fileState struct {
+	info os.FileInfo
+}
+
+func newPoller(path string) *poller {
+	return &poller{path: path, last: stat(path)}
+}
+
+func (p *poller) run(ctx context.Context, interval time.Duration, notify func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		current := stat(p.path)
+
+		if current.changedSince(p.last) {
+			notify()
+		}
+
+		p.last = current
+	}
+}
+
+func stat(path string) fileState {
+	info, err := os.Stat(path)
+
+	if err != nil {
+		return fileState{}
+	}
+
+	return fileState{info: info}
+}
+
+// changedSince reports whether the file differs from the earlier state. A file replaced by a rename
+// is a different file even when its size and modification time match.
+func (s fileState) changedSince(earlier fileState) bool {
+	if s.info == nil || earlier.info == nil {
+		return s.info != earlier.info
+	}
+
+	return !os.SameFile(s.info, earlier.info) ||
+		!s.info.ModTime().Equal(earlier.info.ModTime()) ||
+		s.info.Size() != earlier.info.Size()
+}
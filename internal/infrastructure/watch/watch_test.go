@@ -0,0 +1,160 @@
+package watch
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	testDebounce = 50 * time.Millisecond
+	testInterval = 10 * time.Millisecond
+	// quietPeriod is long enough for a change to be reported by either source.
+	quietPeriod = 300 * time.Millisecond
+)
+
+var modes = []struct {
+	name string
+	poll bool
+}{
+	{name: "Events", poll: false},
+	{name: "Polling", poll: true},
+}
+
+func startWatch(t *testing.T, poll bool) (string, <-chan struct{}) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "tasks.json")
+	require.NoError(t, os.WriteFile(path, []byte("[]"), 0644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	return path, File(ctx, path, Options{Debounce: testDebounce, PollInterval: testInterval, Poll: poll})
+}
+
+func requireChange(t *testing.T, changes <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-changes:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the change was not reported")
+	}
+}
+
+func requireNoChange(t *testing.T, changes <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-changes:
+		t.Fatal("an unexpected change was reported")
+	case <-time.After(quietPeriod):
+	}
+}
+
+func TestFileDebouncesWrites(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range modes {
+		mode := mode
+		t.Run(mode.name, func(t *testing.T) {
+			t.Parallel()
+
+			path, changes := startWatch(t, mode.poll)
+
+			for i := 1; i <= 5; i++ {
+				require.NoError(t, os.WriteFile(path, make([]byte, i), 0644))
+			}
+
+			requireChange(t, changes)
+			requireNoChange(t, changes)
+		})
+	}
+}
+
+func TestFileFollowsAtomicRename(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range modes {
+		mode := mode
+		t.Run(mode.name, func(t *testing.T) {
+			t.Parallel()
+
+			path, changes := startWatch(t, mode.poll)
+			temporary := path + ".tmp"
+
+			require.NoError(t, os.WriteFile(temporary, []byte("[{}]"), 0644))
+			require.NoError(t, os.Rename(temporary, path))
+			requireChange(t, changes)
+
+			// The replacing file is watched as well.
+			require.NoError(t, os.WriteFile(path, []byte("[{}, {}]"), 0644))
+			requireChange(t, changes)
+		})
+	}
+}
+
+func TestFileIgnoresOtherFiles(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range modes {
+		mode := mode
+		t.Run(mode.name, func(t *testing.T) {
+			t.Parallel()
+
+			path, changes := startWatch(t, mode.poll)
+
+			require.NoError(t, os.WriteFile(filepath.Join(filepath.Dir(path), "state.json"), []byte("{}"), 0644))
+			requireNoChange(t, changes)
+		})
+	}
+}
+
+func TestFileClosesWhenDone(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range modes {
+		mode := mode
+		t.Run(mode.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			changes := File(ctx, filepath.Join(t.TempDir(), "tasks.json"), Options{Poll: mode.poll})
+			cancel()
+
+			select {
+			case _, ok := <-changes:
+				require.False(t, ok)
+			case <-time.After(5 * time.Second):
+				t.Fatal("the changes were not closed")
+			}
+		})
+	}
+}
+
+func TestFileStateChangedSince(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tasks.json")
+	missing := stat(path)
+
+	require.NoError(t, os.WriteFile(path, []byte("[]"), 0644))
+	created := stat(path)
+
+	require.True(t, created.changedSince(missing))
+	require.False(t, stat(path).changedSince(created))
+
+	// Same size and modification time, but a different file.
+	other := filepath.Join(dir, "other.json")
+	require.NoError(t, os.WriteFile(other, []byte("[]"), 0644))
+	require.NoError(t, os.Chtimes(other, created.info.ModTime(), created.info.ModTime()))
+	require.NoError(t, os.Rename(other, path))
+
+	require.True(t, stat(path).changedSince(created))
+	require.False(t, missing.changedSince(stat(filepath.Join(dir, "gone.json"))))
+}
@@ -0,0 +1,215 @@
+// Package webhooks delivers task events as signed JSON HTTP requests.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+)
+
+const (
+	EventHeader     = "X-TaskTracker-Event"
+	SignatureHeader = "X-TaskTracker-Signature"
+
+	defaultMaxAttempts = 3
+	defaultBackoff     = time.Second
+	defaultTimeout     = 10 * time.Second
+	// defaultBudget bounds the time spent on delivering one event to a webhook, retries included.
+	defaultBudget = 5 * time.Second
+)
+
+// Webhook is a single configured receiver.
+type Webhook struct {
+	Url string
+	// Secret signs the payload with HMAC-SHA256, no signature header is sent when it is empty.
+	Secret string `json:",omitempty"`
+	// Events limits the deliveries to the listed event types, all events are sent when it is empty.
+	Events []domain.EventType `json:",omitempty"`
+}
+
+// Delivery is one attempt to deliver an event, as written to the delivery log.
+type Delivery struct {
+	Time       time.Time `json:"time"`
+	Event      string    `json:"event"`
+	TaskId     int       `json:"taskId"`
+	Url        string    `json:"url,omitempty"`
+	Attempt    int       `json:"attempt,omitempty"`
+	StatusCode int       `json:"statusCode,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// Dispatcher posts every published event to the webhooks subscribed to it, to all of them at once.
+// Failed deliveries (network errors, 429 and 5xx responses) are retried with a growing delay
+// until the time budget of the delivery is spent, so a slow receiver never delays the others.
+type Dispatcher struct {
+	webhooks    []Webhook
+	client      *http.Client
+	log         io.Writer
+	logMu       sync.Mutex
+	maxAttempts int
+	backoff     time.Duration
+	budget      time.Duration
+	now         func() time.Time
+	sleep       func(time.Duration)
+}
+
+// NewDispatcher creates a Dispatcher for webhooks that writes one JSON line per delivery attempt to log.
+func NewDispatcher(webhooks []Webhook, log io.Writer) *Dispatcher {
+	return &Dispatcher{
+		webhooks:    webhooks,
+		client:      &http.Client{Timeout: defaultTimeout},
+		log:         log,
+		maxAttempts: defaultMaxAttempts,
+		backoff:     defaultBackoff,
+		budget:      defaultBudget,
+		now:         time.Now,
+		sleep:       time.Sleep,
+	}
+}
+
+// Sign returns the value of the signature header for body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (d *Dispatcher) Publish(event domain.Event) {
+	body, err := json.Marshal(domain.NewEventPayload(event))
+
+	if err != nil {
+		d.writeLog(Delivery{Time: d.now(), Event: string(event.Type), TaskId: event.Task.Id, Error: err.Error()})
+		return
+	}
+
+	var wg sync.WaitGroup
+
+	for _, webhook := range d.webhooks {
+		if len(webhook.Events) > 0 && !slices.Contains(webhook.Events, event.Type) {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.deliver(webhook, event, body)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// deliver posts body to webhook within a budget of its own.
+func (d *Dispatcher) deliver(webhook Webhook, event domain.Event, body []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.budget)
+	defer cancel()
+
+	deadline := d.now().Add(d.budget)
+
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		if attempt > 1 {
+			delay := d.backoff * time.Duration(1<<(attempt-2))
+
+			if ctx.Err() != nil || d.now().Add(delay).After(deadline) {
+				d.writeLog(Delivery{
+					Time:    d.now(),
+					Event:   string(event.Type),
+					TaskId:  event.Task.Id,
+					Url:     webhook.Url,
+					Attempt: attempt,
+					Error:   fmt.Sprintf("not attempted, the delivery time budget of %s is spent", d.budget),
+				})
+				return
+			}
+
+			d.sleep(delay)
+		}
+
+		statusCode, err := d.post(ctx, webhook, event, body)
+
+		delivery := Delivery{
+			Time:       d.now(),
+			Event:      string(event.Type),
+			TaskId:     event.Task.Id,
+			Url:        webhook.Url,
+			Attempt:    attempt,
+			StatusCode: statusCode,
+		}
+
+		if err != nil {
+			delivery.Error = err.Error()
+		}
+
+		d.writeLog(delivery)
+
+		if !shouldRetry(statusCode, err) {
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) post(ctx context.Context, webhook Webhook, event domain.Event, body []byte) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventHeader, string(event.Type))
+
+	if webhook.Secret != "" {
+		request.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+	}
+
+	response, err := d.client.Do(request)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("unexpected response status: %s", response.Status)
+	}
+
+	return response.StatusCode, nil
+}
+
+func (d *Dispatcher) writeLog(delivery Delivery) {
+	if d.log == nil {
+		return
+	}
+
+	line, err := json.Marshal(delivery)
+
+	if err != nil {
+		return
+	}
+
+	d.logMu.Lock()
+	defer d.logMu.Unlock()
+
+	_, _ = d.log.Write(append(line, '\n'))
+}
+
+func shouldRetry(statusCode int, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
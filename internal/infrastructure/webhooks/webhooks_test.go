@@ -0,0 +1,293 @@
+package webhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var testTime = time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+
+var testEvent = domain.Event{
+	Type: domain.StatusChanged,
+	Task: domain.Task{
+		Id:            4,
+		Uuid:          "3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f",
+		Description:   "Write report",
+		CurrentStatus: domain.Done,
+		CreatedAt:     testTime,
+		UpdatedAt:     testTime,
+		CompletedAt:   testTime,
+	},
+	PreviousStatus: domain.InProgress,
+	OccurredAt:     testTime,
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver answers with the given status codes in order and records every request.
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []receivedRequest
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, request *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	body, _ := io.ReadAll(request.Body)
+	r.requests = append(r.requests, receivedRequest{header: request.Header.Clone(), body: body})
+
+	status := http.StatusOK
+	if len(r.requests) <= len(r.statuses) {
+		status = r.statuses[len(r.requests)-1]
+	}
+
+	w.WriteHeader(status)
+}
+
+func newTestDispatcher(webhooks []Webhook, log io.Writer) (*Dispatcher, *[]time.Duration) {
+	var sleeps []time.Duration
+
+	dispatcher := NewDispatcher(webhooks, log)
+	dispatcher.now = func() time.Time { return testTime }
+	dispatcher.sleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+
+	return dispatcher, &sleeps
+}
+
+func TestPublishSignedPayload(t *testing.T) {
+	t.Parallel()
+
+	receiver := &receiver{}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	dispatcher, _ := newTestDispatcher([]Webhook{{Url: server.URL, Secret: "s3cret"}}, nil)
+	dispatcher.Publish(testEvent)
+
+	require.Len(t, receiver.requests, 1)
+	request := receiver.requests[0]
+
+	assert.Equal(t, "application/json", request.header.Get("Content-Type"))
+	assert.Equal(t, "task.status_changed", request.header.Get(EventHeader))
+	assert.Equal(t, Sign("s3cret", request.body), request.header.Get(SignatureHeader))
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(request.body, &body))
+
+	assert.Equal(t, map[string]any{
+		"event":          "task.status_changed",
+		"occurredAt":     "2025-09-01T09:00:00Z",
+		"previousStatus": "in-progress",
+		"task": map[string]any{
+			"id":          float64(4),
+			"uuid":        "3f2a9c4e-8b1d-4f6a-9c2e-5d7b1a0e4c3f",
+			"description": "Write report",
+			"status":      "done",
+			"priority":    "none",
+			"createdAt":   "2025-09-01T09:00:00Z",
+			"updatedAt":   "2025-09-01T09:00:00Z",
+			"completedAt": "2025-09-01T09:00:00Z",
+		},
+	}, body)
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		Sign("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
+
+func TestPublishRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		statuses         []int
+		expectedAttempts int
+		expectedSleeps   []time.Duration
+		expectedLog      []string
+	}{
+		{
+			name:             "Delivered at once",
+			statuses:         []int{http.StatusNoContent},
+			expectedAttempts: 1,
+			expectedLog:      []string{`"attempt":1,"statusCode":204}`},
+		},
+		{
+			name:             "Server errors are retried",
+			statuses:         []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK},
+			expectedAttempts: 3,
+			expectedSleeps:   []time.Duration{time.Second, 2 * time.Second},
+			expectedLog: []string{
+				`"attempt":1,"statusCode":500,"error":"unexpected response status: 500 Internal Server Error"}`,
+				`"attempt":2,"statusCode":502,"error":"unexpected response status: 502 Bad Gateway"}`,
+				`"attempt":3,"statusCode":200}`,
+			},
+		},
+		{
+			name:             "Attempts are limited",
+			statuses:         []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			expectedAttempts: 3,
+			expectedSleeps:   []time.Duration{time.Second, 2 * time.Second},
+			expectedLog: []string{
+				`"attempt":1,"statusCode":429,"error":"unexpected response status: 429 Too Many Requests"}`,
+				`"attempt":2,"statusCode":503,"error":"unexpected response status: 503 Service Unavailable"}`,
+				`"attempt":3,"statusCode":503,"error":"unexpected response status: 503 Service Unavailable"}`,
+			},
+		},
+		{
+			name:             "Client errors are not retried",
+			statuses:         []int{http.StatusBadRequest},
+			expectedAttempts: 1,
+			expectedLog:      []string{`"attempt":1,"statusCode":400,"error":"unexpected response status: 400 Bad Request"}`},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			receiver := &receiver{statuses: tt.statuses}
+			server := httptest.NewServer(receiver)
+			defer server.Close()
+
+			var log bytes.Buffer
+			dispatcher, sleeps := newTestDispatcher([]Webhook{{Url: server.URL}}, &log)
+			dispatcher.Publish(testEvent)
+
+			assert.Len(t, receiver.requests, tt.expectedAttempts)
+			assert.Equal(t, tt.expectedSleeps, *sleeps)
+
+			lines := strings.Split(strings.TrimSuffix(log.String(), "\n"), "\n")
+			require.Len(t, lines, len(tt.expectedLog))
+
+			prefix := `{"time":"2025-09-01T09:00:00Z","event":"task.status_changed","taskId":4,"url":"` + server.URL + `",`
+			for i, line := range lines {
+				assert.Equal(t, prefix+tt.expectedLog[i], line)
+			}
+		})
+	}
+}
+
+func TestPublishEventFilter(t *testing.T) {
+	t.Parallel()
+
+	subscribed := &receiver{}
+	subscribedServer := httptest.NewServer(subscribed)
+	defer subscribedServer.Close()
+
+	other := &receiver{}
+	otherServer := httptest.NewServer(other)
+	defer otherServer.Close()
+
+	dispatcher, _ := newTestDispatcher([]Webhook{
+		{Url: subscribedServer.URL, Events: []domain.EventType{domain.TaskCreated, domain.StatusChanged}},
+		{Url: otherServer.URL, Events: []domain.EventType{domain.TaskDeleted}},
+	}, nil)
+	dispatcher.Publish(testEvent)
+
+	assert.Len(t, subscribed.requests, 1)
+	assert.Empty(t, other.requests)
+	assert.Empty(t, subscribed.requests[0].header.Get(SignatureHeader))
+}
+
+func TestPublishBudget(t *testing.T) {
+	t.Parallel()
+
+	receiver := &receiver{statuses: []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK}}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	var log bytes.Buffer
+	clock := testTime
+
+	dispatcher := NewDispatcher([]Webhook{{Url: server.URL}}, &log)
+	dispatcher.budget = 2 * time.Second
+	dispatcher.now = func() time.Time { return clock }
+	dispatcher.sleep = func(d time.Duration) { clock = clock.Add(d) }
+	dispatcher.Publish(testEvent)
+
+	assert.Len(t, receiver.requests, 2)
+
+	lines := strings.Split(strings.TrimSuffix(log.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[2], `"attempt":3,"error":"not attempted, the delivery time budget of 2s is spent"}`)
+}
+
+func TestPublishSlowReceiver(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	var log bytes.Buffer
+	dispatcher, sleeps := newTestDispatcher([]Webhook{{Url: server.URL}}, &log)
+	dispatcher.budget = 50 * time.Millisecond
+
+	start := time.Now()
+	dispatcher.Publish(testEvent)
+
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Empty(t, *sleeps)
+	assert.Contains(t, log.String(), "context deadline exceeded")
+	assert.Contains(t, log.String(), "the delivery time budget of 50ms is spent")
+}
+
+func TestPublishBudgetPerWebhook(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	receiver := &receiver{}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	var log bytes.Buffer
+	dispatcher, _ := newTestDispatcher([]Webhook{{Url: slow.URL}, {Url: server.URL}}, &log)
+	dispatcher.budget = 50 * time.Millisecond
+	dispatcher.Publish(testEvent)
+
+	assert.Len(t, receiver.requests, 1, "a slow receiver does not spend the budget of the others")
+	assert.Contains(t, log.String(), `"url":"`+server.URL+`","attempt":1,"statusCode":200}`)
+}
+
+func TestPublishUnreachable(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	var log bytes.Buffer
+	dispatcher, sleeps := newTestDispatcher([]Webhook{{Url: url}}, &log)
+	dispatcher.Publish(testEvent)
+
+	assert.Len(t, *sleeps, 2)
+	assert.Equal(t, 3, strings.Count(log.String(), `"error":"Post \"`+url+`\"`))
+}
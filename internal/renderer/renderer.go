@@ -0,0 +1,275 @@
+// Package renderer colors the output of the task commands.
+//
+// Colors are described by style specs, space separated words such as "bold red" or
+// "underline bright-cyan", and grouped into a Theme that assigns a style to every themable
+// part of the output. A Renderer applies a theme, or leaves the text untouched when colors are off.
+package renderer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/terminal"
+)
+
+// Mode tells when colors are used.
+type Mode string
+
+const (
+	// Auto uses colors when the output is a terminal and NO_COLOR is not set.
+	Auto   Mode = "auto"
+	Always Mode = "always"
+	Never  Mode = "never"
+)
+
+// NoColorEnv disables colors in Auto mode when set to a non-empty value, see https://no-color.org.
+const NoColorEnv = "NO_COLOR"
+
+// Element is a themable part of the output.
+type Element string
+
+const (
+	Header         Element = "header"
+	Todo           Element = "todo"
+	InProgress     Element = "in_progress"
+	Done           Element = "done"
+	Overdue        Element = "overdue"
+	PriorityHigh   Element = "priority_high"
+	PriorityMedium Element = "priority_medium"
+	PriorityLow    Element = "priority_low"
+	Highlight      Element = "highlight"
+	Warning        Element = "warning"
+)
+
+// Theme maps the elements to style specs. Elements missing from a theme are not styled.
+type Theme map[Element]string
+
+var defaultTheme = Theme{
+	Header:         "bold",
+	Todo:           "yellow",
+	InProgress:     "cyan",
+	Done:           "green",
+	Overdue:        "bold red",
+	PriorityHigh:   "bold magenta",
+	PriorityMedium: "none",
+	PriorityLow:    "dim",
+	Highlight:      "bold underline",
+	Warning:        "bold yellow",
+}
+
+// noStyle is the spec of an element that is shown without any style.
+const noStyle = "none"
+
+var attributes = map[string]int{
+	"bold":      1,
+	"dim":       2,
+	"italic":    3,
+	"underline": 4,
+	"reverse":   7,
+}
+
+var colors = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// Elements returns the themable elements in the order they are documented.
+func Elements() []Element {
+	return []Element{Header, Todo, InProgress, Done, Overdue, PriorityHigh, PriorityMedium, PriorityLow, Highlight, Warning}
+}
+
+// DefaultTheme returns a copy of the built-in theme.
+func DefaultTheme() Theme {
+	theme := make(Theme, len(defaultTheme))
+
+	for element, spec := range defaultTheme {
+		theme[element] = spec
+	}
+
+	return theme
+}
+
+// ParseStyle turns a style spec into the parameters of an SGR escape sequence, e.g. "bold red" into "1;31".
+// The spec "none" is valid and has no parameters.
+func ParseStyle(spec string) (string, error) {
+	words := strings.Fields(strings.ToLower(spec))
+
+	if len(words) == 0 {
+		return "", fmt.Errorf("empty style, use %q for no style", noStyle)
+	}
+
+	if len(words) == 1 && words[0] == noStyle {
+		return "", nil
+	}
+
+	var codes []string
+
+	for _, word := range words {
+		code, ok := styleCode(word)
+
+		if !ok {
+			return "", fmt.Errorf("unknown style %q, expected %s, a color like red or bright-red, or %q",
+				word, strings.Join(attributeNames(), ", "), noStyle)
+		}
+
+		codes = append(codes, strconv.Itoa(code))
+	}
+
+	return strings.Join(codes, ";"), nil
+}
+
+func styleCode(word string) (int, bool) {
+	if code, ok := attributes[word]; ok {
+		return code, true
+	}
+
+	if word == "gray" || word == "grey" {
+		return 90, true
+	}
+
+	if name, ok := strings.CutPrefix(word, "bright-"); ok {
+		if i := slices.Index(colors, name); i >= 0 {
+			return 90 + i, true
+		}
+
+		return 0, false
+	}
+
+	if i := slices.Index(colors, word); i >= 0 {
+		return 30 + i, true
+	}
+
+	return 0, false
+}
+
+func attributeNames() []string {
+	names := make([]string, 0, len(attributes))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+// ParseMode checks a --color or display.color value.
+func ParseMode(value string) (Mode, error) {
+	switch mode := Mode(value); mode {
+	case Auto, Always, Never:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid color mode %q, expected auto, always or never", value)
+	}
+}
+
+// UseColors decides whether output written to out is colored.
+// In Auto mode it has to be a terminal other than TERM=dumb and NO_COLOR must not be set.
+func UseColors(mode Mode, out io.Writer, getenv func(string) string) bool {
+	switch mode {
+	case Always:
+		return true
+	case Never:
+		return false
+	}
+
+	if getenv(NoColorEnv) != "" || getenv("TERM") == "dumb" {
+		return false
+	}
+
+	file, ok := out.(*os.File)
+	return ok && terminal.IsTerminal(file.Fd())
+}
+
+// Renderer styles the parts of the task output.
+type Renderer struct {
+	colors bool
+	styles map[Element]string
+	now    func() time.Time
+}
+
+// New returns a renderer using theme, elements with an invalid spec fall back to the default theme.
+// When colors is false the renderer returns every text unchanged.
+func New(colors bool, theme Theme) *Renderer {
+	styles := make(map[Element]string, len(defaultTheme))
+
+	for _, element := range Elements() {
+		style, err := ParseStyle(theme[element])
+
+		if err != nil {
+			style, _ = ParseStyle(defaultTheme[element])
+		}
+
+		styles[element] = style
+	}
+
+	return &Renderer{colors: colors, styles: styles, now: time.Now}
+}
+
+// WithClock returns a copy of the renderer that tells overdue tasks by now instead of the current time.
+func (r *Renderer) WithClock(now func() time.Time) *Renderer {
+	clone := *r
+	clone.now = now
+	return &clone
+}
+
+// Plain returns a renderer that never adds colors.
+func Plain() *Renderer {
+	return New(false, defaultTheme)
+}
+
+// Colors reports whether the renderer adds colors.
+func (r *Renderer) Colors() bool {
+	return r.colors
+}
+
+// Paint wraps text in the style of element.
+func (r *Renderer) Paint(element Element, text string) string {
+	style := r.styles[element]
+
+	if !r.colors || style == "" || text == "" {
+		return text
+	}
+
+	return "\033[" + style + "m" + text + "\033[0m"
+}
+
+// Status paints text, usually the already padded status name, as a badge of the status color.
+func (r *Renderer) Status(status domain.Status, text string) string {
+	switch status {
+	case domain.Todo:
+		return r.Paint(Todo, text)
+	case domain.InProgress:
+		return r.Paint(InProgress, text)
+	case domain.Done:
+		return r.Paint(Done, text)
+	default:
+		return text
+	}
+}
+
+// Task paints text describing task, overdue tasks in the overdue color and the others by their priority.
+func (r *Renderer) Task(task domain.Task, text string) string {
+	if r.IsOverdue(task) {
+		return r.Paint(Overdue, text)
+	}
+
+	switch task.Priority {
+	case domain.HighPriority:
+		return r.Paint(PriorityHigh, text)
+	case domain.MediumPriority:
+		return r.Paint(PriorityMedium, text)
+	case domain.LowPriority:
+		return r.Paint(PriorityLow, text)
+	default:
+		return text
+	}
+}
+
+// IsOverdue reports whether task is not done and its due date has passed.
+func (r *Renderer) IsOverdue(task domain.Task) bool {
+	return task.CurrentStatus != domain.Done && !task.DueAt.IsZero() && task.DueAt.Before(r.now())
+}
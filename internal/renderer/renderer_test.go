@@ -0,0 +1,184 @@
+package renderer
+
+import (
+	"bytes"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseStyle(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		spec        string
+		expected    string
+		expectedErr string
+	}
+
+	tests := []testCase{
+		{name: "Color", spec: "red", expected: "31"},
+		{name: "Attributes and color", spec: "bold underline green", expected: "1;4;32"},
+		{name: "Bright color", spec: "Bright-Blue", expected: "94"},
+		{name: "Gray", spec: "gray", expected: "90"},
+		{name: "No style", spec: "none", expected: ""},
+		{name: "Empty", spec: " ", expectedErr: `empty style, use "none" for no style`},
+		{
+			name:        "Unknown word",
+			spec:        "bold pink",
+			expectedErr: `unknown style "pink", expected bold, dim, italic, reverse, underline, a color like red or bright-red, or "none"`,
+		},
+		{
+			name:        "Unknown bright color",
+			spec:        "bright-gray",
+			expectedErr: `unknown style "bright-gray", expected bold, dim, italic, reverse, underline, a color like red or bright-red, or "none"`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			style, err := ParseStyle(tt.spec)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, style)
+		})
+	}
+}
+
+func TestDefaultThemeIsValid(t *testing.T) {
+	t.Parallel()
+
+	theme := DefaultTheme()
+
+	for _, element := range Elements() {
+		_, err := ParseStyle(theme[element])
+		assert.NoError(t, err, element)
+	}
+}
+
+func TestUseColors(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		mode     Mode
+		env      map[string]string
+		expected bool
+	}
+
+	tests := []testCase{
+		{name: "Always", mode: Always, env: map[string]string{NoColorEnv: "1"}, expected: true},
+		{name: "Never", mode: Never, expected: false},
+		{name: "Auto without a terminal", mode: Auto, expected: false},
+		{name: "Auto with NO_COLOR", mode: Auto, env: map[string]string{NoColorEnv: "1"}, expected: false},
+		{name: "Auto on a dumb terminal", mode: Auto, env: map[string]string{"TERM": "dumb"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			getenv := func(name string) string { return tt.env[name] }
+			assert.Equal(t, tt.expected, UseColors(tt.mode, &bytes.Buffer{}, getenv))
+		})
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	t.Parallel()
+
+	mode, err := ParseMode("always")
+	assert.NoError(t, err)
+	assert.Equal(t, Always, mode)
+
+	_, err = ParseMode("sometimes")
+	assert.EqualError(t, err, `invalid color mode "sometimes", expected auto, always or never`)
+}
+
+func TestRenderer(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 9, 10, 12, 0, 0, 0, time.UTC)
+	yesterday := now.AddDate(0, 0, -1)
+
+	theme := DefaultTheme()
+	theme[Todo] = "blue"
+	theme[Done] = "not a style"
+	theme[PriorityLow] = "none"
+
+	r := New(true, theme).WithClock(func() time.Time { return now })
+
+	type testCase struct {
+		name     string
+		render   func(r *Renderer) string
+		expected string
+	}
+
+	tests := []testCase{
+		{
+			name:     "Themed status",
+			render:   func(r *Renderer) string { return r.Status(domain.Todo, "todo") },
+			expected: "\033[34mtodo\033[0m",
+		},
+		{
+			name:     "Invalid spec falls back to the default",
+			render:   func(r *Renderer) string { return r.Status(domain.Done, "done") },
+			expected: "\033[32mdone\033[0m",
+		},
+		{
+			name: "Overdue task",
+			render: func(r *Renderer) string {
+				return r.Task(domain.Task{DueAt: yesterday, Priority: domain.HighPriority}, "late")
+			},
+			expected: "\033[1;31mlate\033[0m",
+		},
+		{
+			name: "Done tasks are never overdue",
+			render: func(r *Renderer) string {
+				return r.Task(domain.Task{DueAt: yesterday, CurrentStatus: domain.Done}, "finished")
+			},
+			expected: "finished",
+		},
+		{
+			name:     "High priority",
+			render:   func(r *Renderer) string { return r.Task(domain.Task{Priority: domain.HighPriority}, "urgent") },
+			expected: "\033[1;35murgent\033[0m",
+		},
+		{
+			name:     "Unstyled element",
+			render:   func(r *Renderer) string { return r.Task(domain.Task{Priority: domain.LowPriority}, "later") },
+			expected: "later",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.render(r))
+		})
+	}
+}
+
+func TestPlainRendererKeepsText(t *testing.T) {
+	t.Parallel()
+
+	r := Plain()
+
+	assert.Equal(t, "todo", r.Status(domain.Todo, "todo"))
+	assert.Equal(t, "late", r.Task(domain.Task{DueAt: time.Unix(0, 0)}, "late"))
+	assert.Equal(t, "ID", r.Paint(Header, "ID"))
+	assert.False(t, r.Colors())
+}
@@ -0,0 +1,271 @@
+// Package server exposes the task operations as a local JSON REST API.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/hooks"
+)
+
+const maxRequestBodySize = 1 << 20
+
+type createTaskRequest struct {
+	Description string `json:"description"`
+}
+
+type updateTaskRequest struct {
+	Description *string `json:"description"`
+	Status      *string `json:"status"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// errUnsupportedMediaType is answered with 415 Unsupported Media Type. Accepting JSON bodies only keeps other
+// web pages from changing tasks with form or text/plain requests, which browsers send cross-site without asking.
+var errUnsupportedMediaType = errors.New("request body must be application/json")
+
+// validationError marks client mistakes that are answered with 400 Bad Request.
+type validationError struct {
+	message string
+}
+
+func (e *validationError) Error() string {
+	return e.message
+}
+
+type server struct {
+	service *tasks.Service
+	// mu serializes requests, the task storage reads and rewrites the whole store on every change.
+	mu sync.Mutex
+}
+
+// NewHandler returns the HTTP handler serving the /tasks endpoints backed by service.
+func NewHandler(service *tasks.Service) http.Handler {
+	s := &server{service: service}
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /tasks", s.listTasks)
+	mux.HandleFunc("POST /tasks", s.createTask)
+	mux.HandleFunc("GET /tasks/{id}", s.getTask)
+	mux.HandleFunc("PATCH /tasks/{id}", s.updateTask)
+	mux.HandleFunc("DELETE /tasks/{id}", s.deleteTask)
+
+	return mux
+}
+
+// listTasks returns all tasks, optionally filtered by the status, tag, project and q (text) query parameters.
+// With q the tasks are ranked like the search command, best matches first.
+func (s *server) listTasks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	var status *domain.Status
+
+	if value := query.Get("status"); value != "" {
+		parsed, err := tasks.ParseStatusString(value)
+		if err != nil {
+			writeError(w, &validationError{message: err.Error()})
+			return
+		}
+
+		status = &parsed
+	}
+
+	var all []domain.Task
+	var err error
+
+	if text := query.Get("q"); text != "" {
+		all, err = s.service.SearchTasks([]string{text}, false)
+	} else {
+		all, err = s.service.ListTasks()
+	}
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]domain.TaskPayload, 0, len(all))
+
+	for _, task := range all {
+		if status != nil && task.CurrentStatus != *status {
+			continue
+		}
+		if tag := query.Get("tag"); tag != "" && !task.HasTag(tag) {
+			continue
+		}
+		if project := query.Get("project"); project != "" && !task.HasProject(project) {
+			continue
+		}
+
+		response = append(response, domain.NewTaskPayload(task))
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *server) createTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var request createTaskRequest
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if strings.TrimSpace(request.Description) == "" {
+		writeError(w, &validationError{message: "task description is required"})
+		return
+	}
+
+	task, err := s.service.AddTask(request.Description)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/tasks/%d", task.Id))
+	writeJSON(w, http.StatusCreated, domain.NewTaskPayload(task))
+}
+
+func (s *server) getTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, err := s.resolveTask(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, domain.NewTaskPayload(task))
+}
+
+// updateTask changes the description and/or the status of a task.
+func (s *server) updateTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, err := s.resolveTask(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var request updateTaskRequest
+	if err := decodeBody(r, &request); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if request.Description == nil && request.Status == nil {
+		writeError(w, &validationError{message: "description or status is required"})
+		return
+	}
+
+	if request.Description != nil && strings.TrimSpace(*request.Description) == "" {
+		writeError(w, &validationError{message: "task description must not be empty"})
+		return
+	}
+
+	var status *domain.Status
+	if request.Status != nil {
+		parsed, err := tasks.ParseStatusString(*request.Status)
+		if err != nil {
+			writeError(w, &validationError{message: err.Error()})
+			return
+		}
+
+		status = &parsed
+	}
+
+	updated, err := s.service.EditTask(task.Id, request.Description, status)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, domain.NewTaskPayload(updated))
+}
+
+func (s *server) deleteTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, err := s.resolveTask(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := s.service.DeleteTask(task.Id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// resolveTask finds the task named by the {id} path segment, a numeric ID or a unique UUID prefix.
+func (s *server) resolveTask(r *http.Request) (domain.Task, error) {
+	id, err := s.service.ResolveTaskId(r.PathValue("id"))
+	if err != nil {
+		return domain.Task{}, err
+	}
+
+	return s.service.GetTask(id)
+}
+
+func decodeBody(r *http.Request, target any) error {
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+		return errUnsupportedMediaType
+	}
+
+	decoder := json.NewDecoder(io.LimitReader(r.Body, maxRequestBodySize))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(target); err != nil {
+		return &validationError{message: fmt.Sprintf("invalid request body: %s", err.Error())}
+	}
+
+	return nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var validationErr *validationError
+	var hookErr *hooks.Error
+
+	switch {
+	case errors.Is(err, tasks.ErrTaskNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, tasks.ErrInvalidInput), errors.As(err, &validationErr):
+		status = http.StatusBadRequest
+	case errors.Is(err, errUnsupportedMediaType):
+		status = http.StatusUnsupportedMediaType
+	case errors.As(err, &hookErr):
+		// A pre-* hook rejected the change, the request was understood but is refused.
+		status = http.StatusUnprocessableEntity
+	}
+
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,405 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/application/tasks"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/hooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// memoryStorage is an in-memory domain.TaskStorage.
+type memoryStorage struct {
+	tasks  []domain.Task
+	lastId int
+	err    error
+	saves  int
+}
+
+func (s *memoryStorage) Save(tasks []domain.Task) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.tasks = append([]domain.Task(nil), tasks...)
+	s.saves++
+	return nil
+}
+
+func (s *memoryStorage) Load() ([]domain.Task, error) {
+	return append([]domain.Task(nil), s.tasks...), s.err
+}
+
+func (s *memoryStorage) NextId() (int, error) {
+	s.lastId++
+	return s.lastId, s.err
+}
+
+type noopPublisher struct{}
+
+func (noopPublisher) Before(domain.Event) error { return nil }
+
+func (noopPublisher) Publish(domain.Event) {}
+
+var testTime = time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+
+func newTestServer(t *testing.T) (*httptest.Server, *memoryStorage) {
+	t.Helper()
+
+	storage := &memoryStorage{
+		tasks: []domain.Task{
+			{Id: 1, Uuid: "aaaa1111-0000-4000-8000-000000000001", Description: "Buy groceries #home", CurrentStatus: domain.Todo, CreatedAt: testTime, UpdatedAt: testTime},
+			{Id: 2, Uuid: "bbbb2222-0000-4000-8000-000000000002", Description: "Write report +work", CurrentStatus: domain.InProgress, CreatedAt: testTime, UpdatedAt: testTime},
+			{Id: 3, Uuid: "cccc3333-0000-4000-8000-000000000003", Description: "Call mom #home", CurrentStatus: domain.Done, CreatedAt: testTime, UpdatedAt: testTime, CompletedAt: testTime},
+		},
+		lastId: 3,
+	}
+
+	service := tasks.NewService(storage, noopPublisher{}, func() time.Time { return testTime })
+	server := httptest.NewServer(NewHandler(service))
+	t.Cleanup(server.Close)
+
+	return server, storage
+}
+
+func doRequest(t *testing.T, method, url, body string) *http.Response {
+	t.Helper()
+
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+
+	if body != "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = response.Body.Close() })
+
+	return response
+}
+
+func decodeResponse[T any](t *testing.T, response *http.Response) T {
+	t.Helper()
+
+	var value T
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&value))
+	return value
+}
+
+func TestListTasks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIds []int
+		statusCode  int
+	}{
+		{name: "All tasks", query: "", expectedIds: []int{1, 2, 3}, statusCode: http.StatusOK},
+		{name: "By status", query: "?status=in-progress", expectedIds: []int{2}, statusCode: http.StatusOK},
+		{name: "By tag", query: "?tag=home", expectedIds: []int{1, 3}, statusCode: http.StatusOK},
+		{name: "By project", query: "?project=work", expectedIds: []int{2}, statusCode: http.StatusOK},
+		{name: "By text", query: "?q=MOM", expectedIds: []int{3}, statusCode: http.StatusOK},
+		{name: "Combined filters", query: "?tag=home&status=done", expectedIds: []int{3}, statusCode: http.StatusOK},
+		{name: "Best text matches first", query: "?q=r", expectedIds: []int{2, 1}, statusCode: http.StatusOK},
+		{name: "No matches", query: "?q=nothing", expectedIds: []int{}, statusCode: http.StatusOK},
+		{name: "Text without words", query: "?q=%21%21", statusCode: http.StatusBadRequest},
+		{name: "Invalid status", query: "?status=blocked", statusCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, _ := newTestServer(t)
+			response := doRequest(t, http.MethodGet, server.URL+"/tasks"+tt.query, "")
+
+			assert.Equal(t, tt.statusCode, response.StatusCode)
+			assert.Equal(t, "application/json", response.Header.Get("Content-Type"))
+
+			if tt.statusCode != http.StatusOK {
+				return
+			}
+
+			ids := []int{}
+			for _, task := range decodeResponse[[]domain.TaskPayload](t, response) {
+				ids = append(ids, task.Id)
+			}
+
+			assert.Equal(t, tt.expectedIds, ids)
+		})
+	}
+}
+
+func TestGetTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		ref           string
+		expectedId    int
+		statusCode    int
+		expectedError string
+	}{
+		{name: "By numeric id", ref: "2", expectedId: 2, statusCode: http.StatusOK},
+		{name: "By uuid prefix", ref: "cccc", expectedId: 3, statusCode: http.StatusOK},
+		{name: "Unknown id", ref: "42", statusCode: http.StatusNotFound, expectedError: "task with id [42] not found"},
+		{name: "Unknown reference", ref: "ffff", statusCode: http.StatusNotFound, expectedError: "task with id [ffff] not found"},
+		{name: "Malformed reference", ref: "zzz", statusCode: http.StatusBadRequest, expectedError: "invalid task reference [zzz]: use a task ID or a UUID prefix"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, _ := newTestServer(t)
+			response := doRequest(t, http.MethodGet, server.URL+"/tasks/"+tt.ref, "")
+
+			assert.Equal(t, tt.statusCode, response.StatusCode)
+
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, decodeResponse[errorResponse](t, response).Error)
+				return
+			}
+
+			assert.Equal(t, tt.expectedId, decodeResponse[domain.TaskPayload](t, response).Id)
+		})
+	}
+}
+
+func TestGetTaskResponseFields(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newTestServer(t)
+	response := doRequest(t, http.MethodGet, server.URL+"/tasks/3", "")
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	var fields map[string]any
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&fields))
+
+	assert.Equal(t, map[string]any{
+		"id":          float64(3),
+		"uuid":        "cccc3333-0000-4000-8000-000000000003",
+		"description": "Call mom #home",
+		"status":      "done",
+		"priority":    "none",
+		"createdAt":   "2025-09-01T09:00:00Z",
+		"updatedAt":   "2025-09-01T09:00:00Z",
+		"completedAt": "2025-09-01T09:00:00Z",
+	}, fields)
+}
+
+func TestCreateTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		body          string
+		statusCode    int
+		expectedError string
+	}{
+		{name: "Valid task", body: `{"description":"Plan trip"}`, statusCode: http.StatusCreated},
+		{name: "Empty description", body: `{"description":"  "}`, statusCode: http.StatusBadRequest, expectedError: "task description is required"},
+		{name: "Missing description", body: `{}`, statusCode: http.StatusBadRequest, expectedError: "task description is required"},
+		{name: "Malformed JSON", body: `{"description":`, statusCode: http.StatusBadRequest, expectedError: "invalid request body: unexpected EOF"},
+		{name: "Unknown field", body: `{"title":"Plan trip"}`, statusCode: http.StatusBadRequest, expectedError: `invalid request body: json: unknown field "title"`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, storage := newTestServer(t)
+			response := doRequest(t, http.MethodPost, server.URL+"/tasks", tt.body)
+
+			assert.Equal(t, tt.statusCode, response.StatusCode)
+
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, decodeResponse[errorResponse](t, response).Error)
+				assert.Len(t, storage.tasks, 3)
+				return
+			}
+
+			task := decodeResponse[domain.TaskPayload](t, response)
+			assert.Equal(t, 4, task.Id)
+			assert.Equal(t, "Plan trip", task.Description)
+			assert.Equal(t, "todo", task.Status)
+			assert.Equal(t, "/tasks/4", response.Header.Get("Location"))
+			assert.Len(t, storage.tasks, 4)
+		})
+	}
+}
+
+func TestContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		statusCode  int
+	}{
+		{name: "JSON with charset", method: http.MethodPost, path: "/tasks", contentType: "application/json; charset=utf-8", statusCode: http.StatusCreated},
+		{name: "Plain text", method: http.MethodPost, path: "/tasks", contentType: "text/plain", statusCode: http.StatusUnsupportedMediaType},
+		{name: "Form", method: http.MethodPost, path: "/tasks", contentType: "application/x-www-form-urlencoded", statusCode: http.StatusUnsupportedMediaType},
+		{name: "Missing", method: http.MethodPost, path: "/tasks", statusCode: http.StatusUnsupportedMediaType},
+		{name: "Update", method: http.MethodPatch, path: "/tasks/1", contentType: "text/plain", statusCode: http.StatusUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, storage := newTestServer(t)
+
+			request, err := http.NewRequest(tt.method, server.URL+tt.path, strings.NewReader(`{"description":"Plan trip"}`))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", tt.contentType)
+
+			response, err := http.DefaultClient.Do(request)
+			require.NoError(t, err)
+			defer response.Body.Close()
+
+			assert.Equal(t, tt.statusCode, response.StatusCode)
+
+			if tt.statusCode == http.StatusUnsupportedMediaType {
+				assert.Equal(t, "request body must be application/json", decodeResponse[errorResponse](t, response).Error)
+				assert.Zero(t, storage.saves)
+			}
+		})
+	}
+}
+
+func TestUpdateTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		ref                 string
+		body                string
+		statusCode          int
+		expectedError       string
+		expectedDescription string
+		expectedStatus      string
+	}{
+		{name: "Description only", ref: "1", body: `{"description":"Buy milk"}`, statusCode: http.StatusOK, expectedDescription: "Buy milk", expectedStatus: "todo"},
+		{name: "Status only", ref: "1", body: `{"status":"done"}`, statusCode: http.StatusOK, expectedDescription: "Buy groceries #home", expectedStatus: "done"},
+		{name: "Both fields by uuid prefix", ref: "bbbb", body: `{"description":"Send report","status":"todo"}`, statusCode: http.StatusOK, expectedDescription: "Send report", expectedStatus: "todo"},
+		{name: "No fields", ref: "1", body: `{}`, statusCode: http.StatusBadRequest, expectedError: "description or status is required"},
+		{name: "Empty description", ref: "1", body: `{"description":""}`, statusCode: http.StatusBadRequest, expectedError: "task description must not be empty"},
+		{name: "Invalid status", ref: "1", body: `{"status":"blocked"}`, statusCode: http.StatusBadRequest, expectedError: "invalid status string: blocked"},
+		{name: "Unknown task", ref: "9", body: `{"status":"done"}`, statusCode: http.StatusNotFound, expectedError: "task with id [9] not found"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, storage := newTestServer(t)
+			response := doRequest(t, http.MethodPatch, server.URL+"/tasks/"+tt.ref, tt.body)
+
+			assert.Equal(t, tt.statusCode, response.StatusCode)
+
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, decodeResponse[errorResponse](t, response).Error)
+				return
+			}
+
+			task := decodeResponse[domain.TaskPayload](t, response)
+			assert.Equal(t, tt.expectedDescription, task.Description)
+			assert.Equal(t, tt.expectedStatus, task.Status)
+			assert.Equal(t, 1, storage.saves, "every change is saved at once")
+		})
+	}
+}
+
+func TestDeleteTask(t *testing.T) {
+	t.Parallel()
+
+	server, storage := newTestServer(t)
+
+	response := doRequest(t, http.MethodDelete, server.URL+"/tasks/2", "")
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+	assert.Len(t, storage.tasks, 2)
+
+	response = doRequest(t, http.MethodDelete, server.URL+"/tasks/2", "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	assert.Equal(t, "task with id [2] not found", decodeResponse[errorResponse](t, response).Error)
+}
+
+// rejectingPublisher rejects every change like a failing pre-* hook.
+type rejectingPublisher struct {
+	noopPublisher
+}
+
+func (rejectingPublisher) Before(event domain.Event) error {
+	return &hooks.Error{Hook: "pre-" + hooks.HookName(event), ExitCode: 1, Stderr: "frozen"}
+}
+
+func TestHookRejection(t *testing.T) {
+	t.Parallel()
+
+	storage := &memoryStorage{tasks: []domain.Task{{Id: 1, Description: "Buy groceries"}}, lastId: 1}
+	service := tasks.NewService(storage, rejectingPublisher{}, func() time.Time { return testTime })
+	server := httptest.NewServer(NewHandler(service))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		body          string
+		expectedError string
+	}{
+		{name: "Create", method: http.MethodPost, path: "/tasks", body: `{"description":"Plan trip"}`, expectedError: "pre-add hook failed with exit status 1: frozen"},
+		{name: "Update", method: http.MethodPatch, path: "/tasks/1", body: `{"status":"done"}`, expectedError: "pre-complete hook failed with exit status 1: frozen"},
+		{name: "Delete", method: http.MethodDelete, path: "/tasks/1", expectedError: "pre-delete hook failed with exit status 1: frozen"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			response := doRequest(t, tt.method, server.URL+tt.path, tt.body)
+
+			assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+			assert.Equal(t, tt.expectedError, decodeResponse[errorResponse](t, response).Error)
+		})
+	}
+
+	assert.Zero(t, storage.saves)
+}
+
+func TestStorageError(t *testing.T) {
+	t.Parallel()
+
+	server, storage := newTestServer(t)
+	storage.err = errors.New("disk is full")
+
+	response := doRequest(t, http.MethodGet, server.URL+"/tasks", "")
+
+	assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+	assert.Equal(t, "disk is full", decodeResponse[errorResponse](t, response).Error)
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newTestServer(t)
+	response := doRequest(t, http.MethodPut, server.URL+"/tasks/1", "")
+
+	assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+}
@@ -0,0 +1,96 @@
+package tui
+
+import "unicode/utf8"
+
+type KeyType int
+
+const (
+	KeyRune KeyType = iota
+	KeyEnter
+	KeyEscape
+	KeyBackspace
+	KeyUp
+	KeyDown
+	KeyPageUp
+	KeyPageDown
+	KeyHome
+	KeyEnd
+	KeyTab
+	KeyCtrlC
+	KeyUnknown
+)
+
+// Key is a single key press decoded from terminal input.
+type Key struct {
+	Type KeyType
+	Rune rune
+}
+
+var escapeSequences = map[string]KeyType{
+	"\x1b[A":  KeyUp,
+	"\x1b[B":  KeyDown,
+	"\x1bOA":  KeyUp,
+	"\x1bOB":  KeyDown,
+	"\x1b[5~": KeyPageUp,
+	"\x1b[6~": KeyPageDown,
+	"\x1b[H":  KeyHome,
+	"\x1b[F":  KeyEnd,
+	"\x1b[1~": KeyHome,
+	"\x1b[4~": KeyEnd,
+}
+
+// ParseKeys decodes raw terminal input into key presses. A lone escape byte is an Escape key,
+// unknown escape sequences are reported as KeyUnknown.
+func ParseKeys(input []byte) []Key {
+	var keys []Key
+
+	for len(input) > 0 {
+		switch b := input[0]; {
+		case b == 0x1b:
+			key, size := parseEscape(input)
+			keys = append(keys, key)
+			input = input[size:]
+			continue
+		case b == '\r' || b == '\n':
+			keys = append(keys, Key{Type: KeyEnter})
+		case b == 0x7f || b == 0x08:
+			keys = append(keys, Key{Type: KeyBackspace})
+		case b == '\t':
+			keys = append(keys, Key{Type: KeyTab})
+		case b == 0x03:
+			keys = append(keys, Key{Type: KeyCtrlC})
+		case b < 0x20:
+			keys = append(keys, Key{Type: KeyUnknown})
+		default:
+			r, size := utf8.DecodeRune(input)
+			keys = append(keys, Key{Type: KeyRune, Rune: r})
+			input = input[size:]
+			continue
+		}
+
+		input = input[1:]
+	}
+
+	return keys
+}
+
+func parseEscape(input []byte) (Key, int) {
+	if len(input) == 1 || (input[1] != '[' && input[1] != 'O') {
+		return Key{Type: KeyEscape}, 1
+	}
+
+	for sequence, keyType := range escapeSequences {
+		if len(input) >= len(sequence) && string(input[:len(sequence)]) == sequence {
+			return Key{Type: keyType}, len(sequence)
+		}
+	}
+
+	// Skip an unknown CSI sequence up to and including its final byte.
+	for i := 2; i < len(input); i++ {
+		if input[i] >= 0x40 && input[i] <= 0x7e {
+			return Key{Type: KeyUnknown}, i + 1
+		}
+	}
+
+	return Key{Type: KeyUnknown}, len(input)
+}
@@ -0,0 +1,63 @@
+package tui
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseKeys(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		input    string
+		expected []Key
+	}
+
+	tests := []testCase{
+		{
+			name:     "Runes",
+			input:    "aé",
+			expected: []Key{{Type: KeyRune, Rune: 'a'}, {Type: KeyRune, Rune: 'é'}},
+		},
+		{
+			name:     "Arrows",
+			input:    "\x1b[A\x1b[B\x1bOA",
+			expected: []Key{{Type: KeyUp}, {Type: KeyDown}, {Type: KeyUp}},
+		},
+		{
+			name:     "Paging",
+			input:    "\x1b[5~\x1b[6~",
+			expected: []Key{{Type: KeyPageUp}, {Type: KeyPageDown}},
+		},
+		{
+			name:     "Lone escape",
+			input:    "\x1b",
+			expected: []Key{{Type: KeyEscape}},
+		},
+		{
+			name:     "Escape followed by rune",
+			input:    "\x1bq",
+			expected: []Key{{Type: KeyEscape}, {Type: KeyRune, Rune: 'q'}},
+		},
+		{
+			name:     "Control keys",
+			input:    "\r\x7f\t\x03\x01",
+			expected: []Key{{Type: KeyEnter}, {Type: KeyBackspace}, {Type: KeyTab}, {Type: KeyCtrlC}, {Type: KeyUnknown}},
+		},
+		{
+			name:     "Unknown sequence is skipped",
+			input:    "\x1b[1;5Cx",
+			expected: []Key{{Type: KeyUnknown}, {Type: KeyRune, Rune: 'x'}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, ParseKeys([]byte(tt.input)))
+		})
+	}
+}
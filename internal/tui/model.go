@@ -0,0 +1,458 @@
+// Package tui implements the interactive full-screen task view. The Model is a plain state
+// machine fed with key presses so it can be tested without a terminal, Run connects it to one.
+package tui
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	selectedStart = "\033[7m"
+	selectedEnd   = "\033[0m"
+	dateLayout    = "2006-01-02 15:04"
+	detailLines   = 6
+	helpText      = "↑/↓ move  / filter  a add  e edit  d delete  t todo  p in-progress  x done  r reload  q quit"
+)
+
+// Actions are the application operations the interface is driven by.
+// They are the same functions the cobra commands call, so behavior stays identical.
+type Actions struct {
+	Load         func() ([]domain.Task, error)
+	Add          func(description string) (domain.Task, error)
+	Update       func(id int, description string) error
+	UpdateStatus func(id int, status domain.Status) error
+	Delete       func(id int) error
+	// FormatDate renders the dates of the detail pane, they are shown with dateLayout when it is nil.
+	FormatDate func(t time.Time) string
+}
+
+type mode int
+
+const (
+	browseMode mode = iota
+	filterMode
+	addMode
+	editMode
+	confirmDeleteMode
+)
+
+type Model struct {
+	actions Actions
+	tasks   []domain.Task
+	visible []domain.Task
+	cursor  int
+	offset  int
+	filter  string
+	mode    mode
+	input   string
+	message string
+}
+
+func NewModel(actions Actions) (*Model, error) {
+	model := &Model{actions: actions}
+	return model, model.reload()
+}
+
+// HandleKey applies a key press and reports whether the interface should keep running.
+func (m *Model) HandleKey(key Key) bool {
+	if key.Type == KeyCtrlC {
+		return false
+	}
+
+	switch m.mode {
+	case filterMode:
+		m.handleFilterKey(key)
+	case addMode, editMode:
+		m.handleInputKey(key)
+	case confirmDeleteMode:
+		m.handleConfirmDeleteKey(key)
+	default:
+		return m.handleBrowseKey(key)
+	}
+
+	return true
+}
+
+func (m *Model) handleBrowseKey(key Key) bool {
+	m.message = ""
+
+	switch key.Type {
+	case KeyUp:
+		m.moveCursor(-1)
+	case KeyDown:
+		m.moveCursor(1)
+	case KeyPageUp:
+		m.moveCursor(-10)
+	case KeyPageDown:
+		m.moveCursor(10)
+	case KeyHome:
+		m.moveCursor(-len(m.visible))
+	case KeyEnd:
+		m.moveCursor(len(m.visible))
+	case KeyEscape:
+		m.setFilter("")
+	case KeyRune:
+		switch key.Rune {
+		case 'q':
+			return false
+		case 'k':
+			m.moveCursor(-1)
+		case 'j':
+			m.moveCursor(1)
+		case 'g':
+			m.moveCursor(-len(m.visible))
+		case 'G':
+			m.moveCursor(len(m.visible))
+		case '/':
+			m.mode = filterMode
+		case 'a':
+			m.mode = addMode
+			m.input = ""
+		case 'e':
+			if task, ok := m.selected(); ok {
+				m.mode = editMode
+				m.input = task.Description
+			}
+		case 'd':
+			if _, ok := m.selected(); ok {
+				m.mode = confirmDeleteMode
+			}
+		case 't':
+			m.setStatus(domain.Todo)
+		case 'p':
+			m.setStatus(domain.InProgress)
+		case 'x':
+			m.setStatus(domain.Done)
+		case 'r':
+			m.report(m.reload(), "Tasks reloaded.")
+		}
+	}
+
+	return true
+}
+
+func (m *Model) handleFilterKey(key Key) {
+	switch key.Type {
+	case KeyEnter:
+		m.mode = browseMode
+	case KeyEscape:
+		m.mode = browseMode
+		m.setFilter("")
+	case KeyBackspace:
+		m.setFilter(dropLastRune(m.filter))
+	case KeyRune:
+		m.setFilter(m.filter + string(key.Rune))
+	}
+}
+
+func (m *Model) handleInputKey(key Key) {
+	switch key.Type {
+	case KeyEscape:
+		m.mode = browseMode
+	case KeyBackspace:
+		m.input = dropLastRune(m.input)
+	case KeyRune:
+		m.input += string(key.Rune)
+	case KeyEnter:
+		m.submitInput()
+	}
+}
+
+func (m *Model) submitInput() {
+	description := strings.TrimSpace(m.input)
+	currentMode := m.mode
+	m.mode = browseMode
+
+	if description == "" {
+		m.message = "Error: Task description is required."
+		return
+	}
+
+	if currentMode == addMode {
+		task, err := m.actions.Add(description)
+		m.report(err, fmt.Sprintf("Task added successfully (ID: %d).", task.Id))
+
+		if err == nil {
+			m.selectTask(task.Id)
+		}
+
+		return
+	}
+
+	if task, ok := m.selected(); ok {
+		m.report(m.actions.Update(task.Id, description), "Task updated successfully.")
+	}
+}
+
+func (m *Model) handleConfirmDeleteKey(key Key) {
+	m.mode = browseMode
+
+	if key.Type != KeyRune || (key.Rune != 'y' && key.Rune != 'Y') {
+		m.message = "Delete cancelled."
+		return
+	}
+
+	if task, ok := m.selected(); ok {
+		m.report(m.actions.Delete(task.Id), "Task deleted successfully.")
+	}
+}
+
+func (m *Model) setStatus(status domain.Status) {
+	if task, ok := m.selected(); ok {
+		m.report(m.actions.UpdateStatus(task.Id, status), fmt.Sprintf("Task marked as %s.", status.String()))
+	}
+}
+
+// report reloads the task list after a mutation and shows either the error or the success message.
+func (m *Model) report(err error, success string) {
+	if err == nil {
+		err = m.reload()
+	}
+
+	if err != nil {
+		m.message = "Error: " + err.Error()
+	} else {
+		m.message = success
+	}
+}
+
+func (m *Model) reload() error {
+	tasks, err := m.actions.Load()
+
+	if err != nil {
+		return err
+	}
+
+	selectedId := -1
+	if task, ok := m.selected(); ok {
+		selectedId = task.Id
+	}
+
+	m.tasks = tasks
+	m.applyFilter()
+	m.selectTask(selectedId)
+
+	return nil
+}
+
+func (m *Model) setFilter(filter string) {
+	selectedId := -1
+	if task, ok := m.selected(); ok {
+		selectedId = task.Id
+	}
+
+	m.filter = filter
+	m.applyFilter()
+	m.cursor, m.offset = 0, 0
+	m.selectTask(selectedId)
+}
+
+// applyFilter keeps the tasks whose ID, status or description contain every word of the filter.
+func (m *Model) applyFilter() {
+	words := strings.Fields(strings.ToLower(m.filter))
+	m.visible = m.visible[:0]
+
+	for _, task := range m.tasks {
+		text := strings.ToLower(fmt.Sprintf("%d %s %s", task.Id, task.CurrentStatus.String(), task.Description))
+		matches := true
+
+		for _, word := range words {
+			if !strings.Contains(text, word) {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			m.visible = append(m.visible, task)
+		}
+	}
+
+	m.moveCursor(0)
+}
+
+func (m *Model) selectTask(id int) {
+	for i, task := range m.visible {
+		if task.Id == id {
+			m.cursor = i
+			return
+		}
+	}
+
+	m.moveCursor(0)
+}
+
+func (m *Model) selected() (domain.Task, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.visible) {
+		return domain.Task{}, false
+	}
+
+	return m.visible[m.cursor], true
+}
+
+func (m *Model) moveCursor(delta int) {
+	m.cursor = min(max(m.cursor+delta, 0), max(len(m.visible)-1, 0))
+}
+
+// View renders the whole screen for a terminal of the given size.
+func (m *Model) View(width, height int) string {
+	tableRows := max(height-detailLines-5, 1)
+
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+tableRows {
+		m.offset = m.cursor - tableRows + 1
+	}
+
+	var lines []string
+
+	title := fmt.Sprintf("TaskTracker-CLI  %d/%d tasks", len(m.visible), len(m.tasks))
+	if m.filter != "" {
+		title += "  filter: " + m.filter
+	}
+	lines = append(lines, title)
+	lines = append(lines, fmt.Sprintf("%-4s %-12s %s", "ID", "Status", "Description"))
+
+	for i := m.offset; i < m.offset+tableRows; i++ {
+		if i >= len(m.visible) {
+			lines = append(lines, "")
+			continue
+		}
+
+		task := m.visible[i]
+		row := truncate(fmt.Sprintf("%-4d %-12s %s", task.Id, task.CurrentStatus.String(), task.Description), width)
+
+		if i == m.cursor {
+			row = selectedStart + padRight(row, width) + selectedEnd
+		}
+
+		lines = append(lines, row)
+	}
+
+	lines = append(lines, strings.Repeat("─", max(width, 0)))
+	lines = append(lines, m.detailView(width)...)
+	lines = append(lines, m.statusLine(width), truncate(helpText, width))
+
+	return strings.Join(lines, "\n")
+}
+
+func (m *Model) formatDate(t time.Time) string {
+	if m.actions.FormatDate != nil {
+		return m.actions.FormatDate(t)
+	}
+
+	return t.Format(dateLayout)
+}
+
+func (m *Model) detailView(width int) []string {
+	lines := make([]string, 0, detailLines)
+	task, ok := m.selected()
+
+	if !ok {
+		lines = append(lines, "No task selected.")
+	} else {
+		due, priority := "-", task.Priority.String()
+		if !task.DueAt.IsZero() {
+			due = m.formatDate(task.DueAt)
+		}
+
+		lines = append(lines,
+			truncate(fmt.Sprintf("ID: %d  UUID: %s", task.Id, task.Uuid), width),
+			truncate(fmt.Sprintf("Status: %s  Priority: %s  Due: %s", task.CurrentStatus.String(), priority, due), width),
+			truncate(fmt.Sprintf("Created: %s  Updated: %s", m.formatDate(task.CreatedAt), m.formatDate(task.UpdatedAt)), width),
+		)
+
+		for _, line := range wrap(task.Description, width) {
+			lines = append(lines, line)
+		}
+	}
+
+	for len(lines) < detailLines {
+		lines = append(lines, "")
+	}
+
+	return lines[:detailLines]
+}
+
+func (m *Model) statusLine(width int) string {
+	switch m.mode {
+	case filterMode:
+		return truncateLeft("Filter: "+m.filter+"_", width)
+	case addMode:
+		return truncateLeft("New task: "+m.input+"_", width)
+	case editMode:
+		task, _ := m.selected()
+		return truncateLeft("Edit task "+strconv.Itoa(task.Id)+": "+m.input+"_", width)
+	case confirmDeleteMode:
+		task, _ := m.selected()
+		return truncate(fmt.Sprintf("Delete task %d? (y/n)", task.Id), width)
+	default:
+		return truncate(m.message, width)
+	}
+}
+
+func truncate(text string, width int) string {
+	if utf8.RuneCountInString(text) <= width {
+		return text
+	}
+
+	return string([]rune(text)[:max(width, 0)])
+}
+
+// truncateLeft keeps the end of the text visible, which is where the user is typing.
+func truncateLeft(text string, width int) string {
+	runes := []rune(text)
+
+	if len(runes) <= width {
+		return text
+	}
+
+	return string(runes[len(runes)-max(width, 0):])
+}
+
+func padRight(text string, width int) string {
+	return text + strings.Repeat(" ", max(width-utf8.RuneCountInString(text), 0))
+}
+
+func wrap(text string, width int) []string {
+	var lines []string
+	line := ""
+
+	for _, word := range strings.Fields(text) {
+		if line != "" && utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+
+		if line != "" {
+			line += " "
+		}
+
+		line += word
+
+		for utf8.RuneCountInString(line) > width && width > 0 {
+			lines = append(lines, string([]rune(line)[:width]))
+			line = string([]rune(line)[width:])
+		}
+	}
+
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func dropLastRune(text string) string {
+	if text == "" {
+		return text
+	}
+
+	_, size := utf8.DecodeLastRuneInString(text)
+	return text[:len(text)-size]
+}
@@ -0,0 +1,243 @@
+package tui
+
+import (
+	"fmt"
+	domain "github.com/Lexv0lk/TaskTracker-CLI/internal/domain/tasks"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeStore is an in-memory stand-in for the application layer.
+type fakeStore struct {
+	tasks  []domain.Task
+	nextId int
+	err    error
+}
+
+func newFakeStore() *fakeStore {
+	created := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+
+	return &fakeStore{
+		tasks: []domain.Task{
+			{Id: 1, Description: "Buy groceries", CurrentStatus: domain.Todo, CreatedAt: created, UpdatedAt: created},
+			{Id: 2, Description: "Write report", CurrentStatus: domain.InProgress, CreatedAt: created, UpdatedAt: created},
+			{Id: 3, Description: "Call mom", CurrentStatus: domain.Done, CreatedAt: created, UpdatedAt: created},
+		},
+		nextId: 4,
+	}
+}
+
+func (s *fakeStore) actions() Actions {
+	find := func(id int) (*domain.Task, error) {
+		for i := range s.tasks {
+			if s.tasks[i].Id == id {
+				return &s.tasks[i], nil
+			}
+		}
+
+		return nil, fmt.Errorf("task with id [%d] not found", id)
+	}
+
+	return Actions{
+		Load: func() ([]domain.Task, error) {
+			return append([]domain.Task(nil), s.tasks...), s.err
+		},
+		Add: func(description string) (domain.Task, error) {
+			task := domain.Task{Id: s.nextId, Description: description}
+			s.nextId++
+			s.tasks = append(s.tasks, task)
+			return task, nil
+		},
+		Update: func(id int, description string) error {
+			task, err := find(id)
+			if err == nil {
+				task.Description = description
+			}
+			return err
+		},
+		UpdateStatus: func(id int, status domain.Status) error {
+			task, err := find(id)
+			if err == nil {
+				task.CurrentStatus = status
+			}
+			return err
+		},
+		Delete: func(id int) error {
+			for i := range s.tasks {
+				if s.tasks[i].Id == id {
+					s.tasks = append(s.tasks[:i], s.tasks[i+1:]...)
+					return nil
+				}
+			}
+			return fmt.Errorf("task with id [%d] not found", id)
+		},
+	}
+}
+
+func typeKeys(m *Model, input string) bool {
+	running := true
+
+	for _, key := range ParseKeys([]byte(input)) {
+		running = m.HandleKey(key)
+	}
+
+	return running
+}
+
+func TestModelNavigation(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewModel(newFakeStore().actions())
+	assert.NoError(t, err)
+
+	task, _ := m.selected()
+	assert.Equal(t, 1, task.Id)
+
+	typeKeys(m, "jj")
+	task, _ = m.selected()
+	assert.Equal(t, 3, task.Id)
+
+	typeKeys(m, "j")
+	task, _ = m.selected()
+	assert.Equal(t, 3, task.Id, "cursor stays on the last task")
+
+	typeKeys(m, "\x1b[A")
+	task, _ = m.selected()
+	assert.Equal(t, 2, task.Id)
+
+	assert.False(t, typeKeys(m, "q"))
+}
+
+func TestModelFilterAsYouType(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewModel(newFakeStore().actions())
+	assert.NoError(t, err)
+
+	typeKeys(m, "/WRI")
+	assert.Len(t, m.visible, 1)
+	assert.Equal(t, 2, m.visible[0].Id)
+
+	typeKeys(m, "\x7f\x7f")
+	assert.Len(t, m.visible, 1)
+
+	typeKeys(m, "\x7f")
+	assert.Len(t, m.visible, 3)
+
+	typeKeys(m, "o\r")
+	assert.Len(t, m.visible, 3, "\"o\" matches todo, in-progress and done")
+	typeKeys(m, "/\x7fw\r")
+	assert.Equal(t, browseMode, m.mode)
+	assert.Equal(t, "w", m.filter)
+
+	typeKeys(m, "\x1b")
+	assert.Equal(t, "", m.filter)
+	assert.Len(t, m.visible, 3)
+
+	typeKeys(m, "/done")
+	assert.Equal(t, []domain.Task{newFakeStore().tasks[2]}, m.visible)
+}
+
+func TestModelMutations(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeStore()
+	m, err := NewModel(store.actions())
+	assert.NoError(t, err)
+
+	typeKeys(m, "aPlan trip\r")
+	assert.Equal(t, "Task added successfully (ID: 4).", m.message)
+	task, _ := m.selected()
+	assert.Equal(t, 4, task.Id)
+
+	typeKeys(m, "e\x7f\x7f\x7f\x7fholiday\r")
+	assert.Equal(t, "Plan holiday", store.tasks[3].Description)
+
+	typeKeys(m, "p")
+	assert.Equal(t, domain.InProgress, store.tasks[3].CurrentStatus)
+
+	typeKeys(m, "x")
+	assert.Equal(t, domain.Done, store.tasks[3].CurrentStatus)
+	assert.Equal(t, "Task marked as done.", m.message)
+
+	typeKeys(m, "dn")
+	assert.Len(t, store.tasks, 4)
+	assert.Equal(t, "Delete cancelled.", m.message)
+
+	typeKeys(m, "dy")
+	assert.Len(t, store.tasks, 3)
+	task, _ = m.selected()
+	assert.Equal(t, 3, task.Id, "selection moves to the neighbouring task")
+
+	typeKeys(m, "a\r")
+	assert.Equal(t, "Error: Task description is required.", m.message)
+	assert.Len(t, store.tasks, 3)
+}
+
+func TestModelReportsErrors(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeStore()
+	m, err := NewModel(store.actions())
+	assert.NoError(t, err)
+
+	store.err = assert.AnError
+	typeKeys(m, "r")
+	assert.Equal(t, "Error: "+assert.AnError.Error(), m.message)
+
+	store.err = nil
+	_, err = NewModel(Actions{Load: func() ([]domain.Task, error) { return nil, assert.AnError }})
+	assert.EqualError(t, err, assert.AnError.Error())
+}
+
+func TestModelView(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewModel(newFakeStore().actions())
+	assert.NoError(t, err)
+
+	typeKeys(m, "j")
+	lines := strings.Split(m.View(40, 14), "\n")
+
+	assert.Len(t, lines, 14)
+	assert.Equal(t, "TaskTracker-CLI  3/3 tasks", lines[0])
+	assert.Equal(t, "ID   Status       Description", lines[1])
+	assert.Equal(t, "1    todo         Buy groceries", lines[2])
+	assert.Equal(t, selectedStart+"2    in-progress  Write report          "+selectedEnd, lines[3])
+	assert.Equal(t, "ID: 2  UUID: ", lines[6])
+	assert.Equal(t, "Write report", lines[9])
+	assert.Equal(t, truncate(helpText, 40), lines[13])
+
+	typeKeys(m, "/xyz")
+	lines = strings.Split(m.View(40, 14), "\n")
+	assert.Equal(t, "No task selected.", lines[6])
+	assert.Equal(t, "Filter: xyz_", lines[12])
+}
+
+func TestModelViewFormatsDates(t *testing.T) {
+	t.Parallel()
+
+	actions := newFakeStore().actions()
+	actions.FormatDate = func(t time.Time) string { return "3h ago" }
+
+	m, err := NewModel(actions)
+	assert.NoError(t, err)
+
+	lines := strings.Split(m.View(60, 14), "\n")
+	assert.Equal(t, "Created: 3h ago  Updated: 3h ago", lines[8])
+}
+
+func TestModelViewScrolls(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewModel(newFakeStore().actions())
+	assert.NoError(t, err)
+
+	typeKeys(m, "G")
+	lines := strings.Split(m.View(40, 12), "\n")
+
+	assert.Len(t, lines, 12)
+	assert.Equal(t, selectedStart+"3    done         Call mom              "+selectedEnd, lines[2])
+}
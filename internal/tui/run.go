@@ -0,0 +1,100 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Lexv0lk/TaskTracker-CLI/internal/infrastructure/terminal"
+)
+
+const (
+	enterAltScreen = "\033[?1049h\033[?25l"
+	leaveAltScreen = "\033[?25h\033[?1049l"
+	clearScreen    = "\033[H\033[2J"
+)
+
+// Run shows the model full-screen on the terminal behind in until the user quits.
+// The view is drawn again after every key and whenever the terminal is resized.
+func Run(in *os.File, out io.Writer, model *Model) error {
+	fd := in.Fd()
+	state, err := terminal.MakeRaw(fd)
+
+	if err != nil {
+		return fmt.Errorf("interactive mode requires a terminal: %w", err)
+	}
+
+	defer terminal.Restore(fd, state)
+
+	io.WriteString(out, enterAltScreen)
+	defer io.WriteString(out, leaveAltScreen)
+
+	resized := make(chan os.Signal, 1)
+	terminal.NotifyResize(resized)
+	defer terminal.StopResize(resized)
+
+	done := make(chan struct{})
+	defer close(done)
+
+	input, readErr := readInput(in, done)
+	size := func() (int, int, error) { return terminal.Size(fd) }
+
+	return loop(out, model, size, input, readErr, resized)
+}
+
+// readInput forwards what is read from in until reading fails or done is closed.
+func readInput(in io.Reader, done <-chan struct{}) (<-chan []byte, <-chan error) {
+	input := make(chan []byte)
+	readErr := make(chan error, 1)
+
+	go func() {
+		for {
+			buffer := make([]byte, 256)
+			n, err := in.Read(buffer)
+
+			if err != nil {
+				readErr <- err
+				return
+			}
+
+			select {
+			case input <- buffer[:n]:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return input, readErr
+}
+
+func loop(out io.Writer, model *Model, size func() (int, int, error), input <-chan []byte, readErr <-chan error,
+	resized <-chan os.Signal) error {
+	for {
+		width, height, err := size()
+
+		if err != nil {
+			return err
+		}
+
+		// Raw mode disables output processing, so lines need an explicit carriage return.
+		view := strings.ReplaceAll(model.View(width, height), "\n", "\r\n")
+
+		if _, err := io.WriteString(out, clearScreen+view); err != nil {
+			return err
+		}
+
+		select {
+		case data := <-input:
+			for _, key := range ParseKeys(data) {
+				if !model.HandleKey(key) {
+					return nil
+				}
+			}
+		case err := <-readErr:
+			return err
+		case <-resized:
+		}
+	}
+}
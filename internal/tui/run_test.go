@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoopRedrawsOnResize(t *testing.T) {
+	t.Parallel()
+
+	model, err := NewModel(newFakeStore().actions())
+	require.NoError(t, err)
+
+	sizes := [][2]int{{80, 24}, {120, 40}}
+	size := func() (int, int, error) {
+		current := sizes[0]
+		if len(sizes) > 1 {
+			sizes = sizes[1:]
+		}
+
+		return current[0], current[1], nil
+	}
+
+	input := make(chan []byte)
+	resized := make(chan os.Signal)
+
+	var out bytes.Buffer
+	done := make(chan error)
+
+	go func() {
+		done <- loop(&out, model, size, input, make(chan error), resized)
+	}()
+
+	resized <- os.Interrupt
+	input <- []byte("q")
+	require.NoError(t, <-done)
+
+	frames := strings.Split(out.String(), clearScreen)
+	require.Len(t, frames, 3)
+	assert.Equal(t, strings.ReplaceAll(model.View(80, 24), "\n", "\r\n"), frames[1])
+	assert.Equal(t, strings.ReplaceAll(model.View(120, 40), "\n", "\r\n"), frames[2])
+}
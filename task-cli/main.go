@@ -1,6 +1,11 @@
 package main
 
-import "github.com/Lexv0lk/TaskTracker-CLI/cmd"
+import (
+	"github.com/Lexv0lk/TaskTracker-CLI/cmd"
+
+	// Time zone names work without a zone database on the system, e.g. on Windows.
+	_ "time/tzdata"
+)
 
 func main() {
 	cmd.Execute()